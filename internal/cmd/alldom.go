@@ -22,7 +22,7 @@ func init() {
 		Short:   "List your AllDom services",
 		Run:     alldom.ListAllDom,
 	}
-	alldomCmd.AddCommand(withFilterFlag(alldomListCmd))
+	alldomCmd.AddCommand(withIAMTagsFlag(withFilterFlag(alldomListCmd)))
 
 	// Command to get a single AllDom
 	alldomCmd.AddCommand(&cobra.Command{
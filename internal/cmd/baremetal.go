@@ -24,7 +24,7 @@ func init() {
 		Short:   "List your Baremetal services",
 		Run:     baremetal.ListBaremetal,
 	}
-	baremetalCmd.AddCommand(withFilterFlag(baremetalListCmd))
+	baremetalCmd.AddCommand(withIAMTagsFlag(withFilterFlag(baremetalListCmd)))
 
 	// Command to get a single Baremetal
 	baremetalCmd.AddCommand(&cobra.Command{
@@ -22,7 +22,7 @@ func init() {
 		Short:   "List your dedicated CDN services",
 		Run:     cdndedicated.ListCdnDedicated,
 	}
-	cdndedicatedCmd.AddCommand(withFilterFlag(cdndedicatedListCmd))
+	cdndedicatedCmd.AddCommand(withIAMTagsFlag(withFilterFlag(cdndedicatedListCmd)))
 
 	// Command to get a single CdnDedicated
 	cdndedicatedCmd.AddCommand(&cobra.Command{
@@ -81,6 +81,262 @@ func initCloudDatabaseCommand(cloudCmd *cobra.Command) {
 		Args:  cobra.ExactArgs(2),
 	})
 
+	// User commands
+	userCmd := &cobra.Command{
+		Use:   "user",
+		Short: "Manage users of a specific database service",
+	}
+	databaseCmd.AddCommand(userCmd)
+
+	userCmd.AddCommand(withFilterFlag(&cobra.Command{
+		Use:     "list <cluster_id>",
+		Aliases: []string{"ls"},
+		Short:   "List all users of the given database service",
+		Run:     cloud.ListDatabaseUsers,
+		Args:    cobra.ExactArgs(1),
+	}))
+
+	userCreateCmd := &cobra.Command{
+		Use:   "create <cluster_id> <username>",
+		Short: "Create a new user in the given database service",
+		Long: `Create a new user in the given database service.
+
+The generated password is only displayed once, at creation.
+
+Example:
+	ovhcloud cloud database-service user create <cluster_id> app --role replication
+	ovhcloud cloud database-service user create <cluster_id> reporting --role read@analytics`,
+		Run:  cloud.CreateDatabaseUser,
+		Args: cobra.ExactArgs(2),
+	}
+	userCreateCmd.Flags().StringSliceVar(&cloud.DatabaseUserSpec.Roles, "role", nil, "Roles granted to the user (PostgreSQL and MongoDB only)")
+	userCmd.AddCommand(userCreateCmd)
+
+	userCmd.AddCommand(&cobra.Command{
+		Use:   "delete <cluster_id> <user_id>",
+		Short: "Delete a specific user of the given database service",
+		Run:   cloud.DeleteDatabaseUser,
+		Args:  cobra.ExactArgs(2),
+	})
+
+	userCmd.AddCommand(&cobra.Command{
+		Use:   "reset-password <cluster_id> <user_id>",
+		Short: "Generate a new password for the given user",
+		Run:   cloud.ResetDatabaseUserPassword,
+		Args:  cobra.ExactArgs(2),
+	})
+
+	// Connection helper
+	connectCmd := &cobra.Command{
+		Use:   "connect <cluster_id>",
+		Short: "Display the connection URI of the given database service, or connect to it",
+		Long: `Display the connection URI of the given database service, or connect to it using the engine client.
+
+The URI is built according to the engine of the service (postgres://, mysql://,
+rediss:// or mongodb+srv://). With --launch, the engine client (psql, mysql,
+redis-cli, valkey-cli or mongosh) found in PATH is executed. When no password is
+given, the client prompts for it. Otherwise, it is given to psql, mysql, redis-cli and
+valkey-cli through the environment (PGPASSWORD, MYSQL_PWD or REDISCLI_AUTH) rather than
+on the command line. The password is never given to mongosh, which always prompts for it.
+
+Examples:
+	ovhcloud cloud database-service connect <cluster_id> --database app --user app
+	ovhcloud cloud database-service connect <cluster_id> --ca-file ./ca.pem --launch`,
+		Run:  cloud.ConnectDatabase,
+		Args: cobra.ExactArgs(1),
+	}
+	connectCmd.Flags().StringVar(&cloud.DatabaseConnectParams.Database, "database", "", "Database to connect to (defaults to the engine default database)")
+	connectCmd.Flags().StringVar(&cloud.DatabaseConnectParams.User, "user", "", "User to connect as (defaults to the engine admin user)")
+	connectCmd.Flags().StringVar(&cloud.DatabaseConnectParams.Password, "password", "", "Password of the user")
+	connectCmd.Flags().StringVar(&cloud.DatabaseConnectParams.CAFile, "ca-file", "", "Download the CA certificate of the service to the given file and use it to verify the server")
+	connectCmd.Flags().BoolVar(&cloud.DatabaseConnectParams.Launch, "launch", false, "Launch the engine client instead of displaying the URI")
+	databaseCmd.AddCommand(connectCmd)
+
+	// IP restriction commands
+	ipRestrictionCmd := &cobra.Command{
+		Use:   "ip-restriction",
+		Short: "Manage IP blocks allowed to connect to a specific database service",
+	}
+	databaseCmd.AddCommand(ipRestrictionCmd)
+
+	ipRestrictionCmd.AddCommand(withFilterFlag(&cobra.Command{
+		Use:     "list <cluster_id>",
+		Aliases: []string{"ls"},
+		Short:   "List IP blocks allowed to connect to the given database service",
+		Run:     cloud.ListDatabaseIPRestrictions,
+		Args:    cobra.ExactArgs(1),
+	}))
+
+	ipRestrictionAddCmd := &cobra.Command{
+		Use:   "add <cluster_id> <cidr>...",
+		Short: "Allow the given IP blocks to connect to the given database service",
+		Long: `Allow the given IP blocks to connect to the given database service.
+
+The given IP blocks are merged with the existing ones: blocks already allowed
+are left untouched, unless a different description is given. Single IP
+addresses are converted to /32 (or /128) blocks.
+
+Example:
+	ovhcloud cloud database-service ip-restriction add <cluster_id> 203.0.113.0/24 --description office
+	ovhcloud cloud database-service ip-restriction add <cluster_id> 198.51.100.10 198.51.100.11`,
+		Run:  cloud.AddDatabaseIPRestrictions,
+		Args: cobra.MinimumNArgs(2),
+	}
+	ipRestrictionAddCmd.Flags().StringVar(&cloud.DatabaseIPRestrictionDescription, "description", "", "Description of the IP blocks")
+	ipRestrictionCmd.AddCommand(ipRestrictionAddCmd)
+
+	ipRestrictionCmd.AddCommand(&cobra.Command{
+		Use:   "remove <cluster_id> <cidr>...",
+		Short: "Remove the given IP blocks from the ones allowed to connect to the given database service",
+		Run:   cloud.RemoveDatabaseIPRestrictions,
+		Args:  cobra.MinimumNArgs(2),
+	})
+
+	// Integration commands
+	integrationCmd := &cobra.Command{
+		Use:   "integration",
+		Short: "Manage integrations between database services",
+	}
+	databaseCmd.AddCommand(integrationCmd)
+
+	integrationCmd.AddCommand(withFilterFlag(&cobra.Command{
+		Use:     "list <cluster_id>",
+		Aliases: []string{"ls"},
+		Short:   "List integrations of the given database service",
+		Run:     cloud.ListDatabaseIntegrations,
+		Args:    cobra.ExactArgs(1),
+	}))
+
+	integrationCreateCmd := &cobra.Command{
+		Use:   "create <cluster_id>",
+		Short: "Create an integration from the given database service to another one",
+		Long: `Create an integration from the given database service to another one.
+
+The integration type must be available between the engines of both services,
+as listed in the database capabilities of the project.
+
+Example:
+	ovhcloud cloud database-service integration create <kafka_cluster_id> --destination <opensearch_cluster_id> --type opensearchLogs
+	ovhcloud cloud database-service integration create <cluster_id> --destination <m3db_cluster_id> --type m3dbMetrics`,
+		Run:  cloud.CreateDatabaseIntegration,
+		Args: cobra.ExactArgs(1),
+	}
+	integrationCreateCmd.Flags().StringVar(&cloud.DatabaseIntegrationSpec.DestinationServiceID, "destination", "", "ID of the destination database service")
+	integrationCreateCmd.Flags().StringVar(&cloud.DatabaseIntegrationSpec.Type, "type", "", "Type of the integration")
+	integrationCreateCmd.Flags().StringToStringVar(&cloud.DatabaseIntegrationSpec.Parameters, "parameter", nil, "Parameters of the integration as key=value pairs")
+	integrationCreateCmd.MarkFlagRequired("destination")
+	integrationCreateCmd.MarkFlagRequired("type")
+	integrationCmd.AddCommand(integrationCreateCmd)
+
+	integrationCmd.AddCommand(&cobra.Command{
+		Use:   "delete <cluster_id> <integration_id>",
+		Short: "Delete a specific integration of the given database service",
+		Run:   cloud.DeleteDatabaseIntegration,
+		Args:  cobra.ExactArgs(2),
+	})
+
+	// Monitoring commands
+	logsCmd := &cobra.Command{
+		Use:   "logs <cluster_id>",
+		Short: "Display the latest logs of the given database service",
+		Long: `Display the latest logs of the given database service.
+
+With --follow, the logs are polled every few seconds and only new entries are displayed.
+
+Examples:
+	ovhcloud cloud database-service logs <cluster_id>
+	ovhcloud cloud database-service logs <cluster_id> --follow`,
+		Run:  cloud.GetDatabaseLogs,
+		Args: cobra.ExactArgs(1),
+	}
+	logsCmd.Flags().BoolVar(&cloud.DatabaseLogsFollow, "follow", false, "Keep polling for new logs")
+	databaseCmd.AddCommand(logsCmd)
+
+	metricsCmd := &cobra.Command{
+		Use:   "metrics <cluster_id>",
+		Short: "Display a metric of the given database service",
+		Long: `Display a metric of the given database service, as a chart per node.
+
+Without --metric, the metrics available for the service are listed.
+Use --json, --yaml or --csv to export the data points.
+
+Examples:
+	ovhcloud cloud database-service metrics <cluster_id>
+	ovhcloud cloud database-service metrics <cluster_id> --metric cpu_usage_percent --period lastDay
+	ovhcloud cloud database-service metrics <cluster_id> --metric mem_usage_percent --period lastWeek --csv > mem.csv`,
+		Run:  cloud.GetDatabaseMetrics,
+		Args: cobra.ExactArgs(1),
+	}
+	metricsCmd.Flags().StringVar(&cloud.DatabaseMetricsParams.Metric, "metric", "", "Name of the metric to display")
+	metricsCmd.Flags().StringVar(&cloud.DatabaseMetricsParams.Period, "period", "lastDay", "Period of the metric (lastHour, lastDay, lastWeek, lastMonth, lastYear)")
+	metricsCmd.Flags().BoolVar(&cloud.DatabaseMetricsParams.CSV, "csv", false, "Output data points in CSV")
+	databaseCmd.AddCommand(metricsCmd)
+
+	// Backup commands
+	backupCmd := &cobra.Command{
+		Use:   "backup",
+		Short: "Manage backups of a specific database service",
+	}
+	databaseCmd.AddCommand(backupCmd)
+
+	backupCmd.AddCommand(withFilterFlag(&cobra.Command{
+		Use:     "list <cluster_id>",
+		Aliases: []string{"ls"},
+		Short:   "List all backups of the given database service",
+		Run:     cloud.ListDatabaseBackups,
+		Args:    cobra.ExactArgs(1),
+	}))
+
+	backupCmd.AddCommand(&cobra.Command{
+		Use:   "get <cluster_id> <backup_id>",
+		Short: "Get a specific backup of the given database service",
+		Run:   cloud.GetDatabaseBackup,
+		Args:  cobra.ExactArgs(2),
+	})
+
+	restoreCmd := &cobra.Command{
+		Use:   "restore <cluster_id>",
+		Short: "Restore the given database service at a point in time",
+		Long: `Restore the given database service at a point in time.
+
+The restoration is done in a new database service, forked from the given one
+at the requested point in time. The new service uses the same plan, flavor,
+network and IP restrictions as the source service, and the command waits
+for it to be READY. The source service is left untouched.
+
+Example:
+	ovhcloud cloud database-service restore <cluster_id> --point-in-time 2025-10-01T12:00:00Z
+	ovhcloud cloud database-service restore <cluster_id> --point-in-time 2025-10-01T12:00:00Z --name my-restored-db`,
+		Run:  cloud.RestoreDatabase,
+		Args: cobra.ExactArgs(1),
+	}
+	restoreCmd.Flags().StringVar(&cloud.DatabaseForkParams.PointInTime, "point-in-time", "", "Point in time to restore, as a RFC3339 timestamp")
+	restoreCmd.Flags().StringVar(&cloud.DatabaseForkParams.Name, "name", "", "Description of the restored service (defaults to <cluster_id>-restore-<timestamp>)")
+	restoreCmd.MarkFlagRequired("point-in-time")
+	databaseCmd.AddCommand(restoreCmd)
+
+	forkCmd := &cobra.Command{
+		Use:   "fork <cluster_id>",
+		Short: "Create a new database service from a backup of the given one",
+		Long: `Create a new database service from a backup or a point in time of the given one.
+
+The new service uses the same plan, flavor, network and IP restrictions as the
+source service, and the command waits for it to be READY.
+
+Example:
+	ovhcloud cloud database-service fork <cluster_id> --backup <backup_id> --name my-fork
+	ovhcloud cloud database-service fork <cluster_id> --point-in-time 2025-10-01T12:00:00Z --name my-fork`,
+		Run:  cloud.ForkDatabase,
+		Args: cobra.ExactArgs(1),
+	}
+	forkCmd.Flags().StringVar(&cloud.DatabaseForkParams.BackupID, "backup", "", "ID of the backup to fork from")
+	forkCmd.Flags().StringVar(&cloud.DatabaseForkParams.PointInTime, "point-in-time", "", "Point in time to fork from, as a RFC3339 timestamp")
+	forkCmd.Flags().StringVar(&cloud.DatabaseForkParams.Name, "name", "", "Description of the new service")
+	forkCmd.MarkFlagRequired("name")
+	forkCmd.MarkFlagsOneRequired("backup", "point-in-time")
+	forkCmd.MarkFlagsMutuallyExclusive("backup", "point-in-time")
+	databaseCmd.AddCommand(forkCmd)
+
 	cloudCmd.AddCommand(databaseCmd)
 }
 
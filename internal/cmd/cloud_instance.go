@@ -6,6 +6,8 @@ package cmd
 
 import (
 	"runtime"
+	"strings"
+	"time"
 
 	"github.com/ovh/ovhcloud-cli/internal/assets"
 	"github.com/ovh/ovhcloud-cli/internal/flags"
@@ -61,6 +63,15 @@ There are three ways to define the creation parameters:
   You can also use the interactive image and flavor selector to define the image and flavor parameters, like the following:
 
 	ovhcloud cloud instance create RBX8 --editor --image-selector --flavor-selector
+
+User data can be given inline using --user-data, or built from files using --user-data-file. Files are rendered as Go templates
+(variables {{.Name}}, {{.Region}}, {{.Project}} and {{.Vars.xxx}} given with --user-data-var) and validated before the creation.
+{{.Name}} is taken from --name or --from-file, it cannot be used when the name is only given using --editor.
+When several files are given (cloud-config, shell scripts…), they are assembled in a MIME multipart document:
+
+	ovhcloud cloud instance create GRA11 --from-file ./params.json --user-data-file cloud-config.yaml --user-data-file setup.sh --user-data-var env=prod
+
+  Use 'ovhcloud cloud instance user-data render' to preview the final payload.
 `,
 		Run:  cloud.CreateInstance,
 		Args: cobra.MaximumNArgs(1),
@@ -72,6 +83,9 @@ There are three ways to define the creation parameters:
 	instanceCreateCmd.Flags().IntVar(&cloud.InstanceCreationParameters.Bulk, "bulk", 0, "Number of instances to create")
 	instanceCreateCmd.Flags().StringVar(&cloud.InstanceCreationParameters.Flavor.ID, "flavor", "", "Flavor ID (you can use 'ovhcloud cloud reference list-flavors' to get the flavor ID)")
 	instanceCreateCmd.Flags().StringVar(&cloud.InstanceCreationParameters.Group.ID, "group", "", "Group ID")
+	instanceCreateCmd.Flags().StringVar(&cloud.InstanceCreationGroup.Name, "group-create", "", "Name of an instance group to create and add the instance to")
+	instanceCreateCmd.Flags().StringVar(&cloud.InstanceCreationGroup.Policy, "group-policy", "anti-affinity", "Policy of the instance group created with --group-create ("+strings.Join(cloud.InstanceGroupPolicies, ", ")+")")
+	instanceCreateCmd.MarkFlagsMutuallyExclusive("group", "group-create")
 	instanceCreateCmd.Flags().StringVar(&cloud.InstanceCreationParameters.Name, "name", "", "Instance name")
 
 	// Boot options
@@ -126,6 +140,8 @@ There are three ways to define the creation parameters:
 
 	// User Data
 	instanceCreateCmd.Flags().StringVar(&cloud.InstanceCreationParameters.UserData, "user-data", "", "Configuration information or scripts to use upon launch")
+	addInstanceUserDataFlags(instanceCreateCmd)
+	instanceCreateCmd.MarkFlagsMutuallyExclusive("user-data", "user-data-file")
 
 	// Common flags for other mean to define parameters
 	addInitParameterFileFlag(instanceCreateCmd, assets.CloudOpenapiSchema, "/cloud/project/{serviceName}/instance", "post", cloud.CloudInstanceCreationExample, cloud.GetInstanceFlavorAndImageInteractiveSelector)
@@ -165,6 +181,39 @@ func initInstanceCommand(cloudCmd *cobra.Command) {
 
 	instanceCmd.AddCommand(getInstanceCreationCmd())
 
+	// User data commands
+	userDataCmd := &cobra.Command{
+		Use:   "user-data",
+		Short: "Manage cloud-init user data of instances",
+	}
+	instanceCmd.AddCommand(userDataCmd)
+
+	userDataRenderCmd := &cobra.Command{
+		Use:   "render",
+		Short: "Render user data files as they would be sent on instance creation",
+		Long: `Render user data files as they would be sent on instance creation.
+
+User data files are Go templates in which the following variables are available:
+  {{.Name}}     Name of the instance (--name)
+  {{.Region}}   Region of the instance (--region)
+  {{.Project}}  ID of the cloud project
+  {{.Vars.xxx}} Custom variables given using --user-data-var xxx=value
+
+The type of each file is detected from its first line (#cloud-config, #!, #cloud-boothook, #include).
+Cloud-config files are validated before being rendered. When several files are given, they are
+assembled in a MIME multipart document.
+
+Example:
+  ovhcloud cloud instance user-data render --user-data-file cloud-config.yaml --user-data-file setup.sh --name web-1 --region GRA11`,
+		Run:  cloud.RenderInstanceUserData,
+		Args: cobra.NoArgs,
+	}
+	addInstanceUserDataFlags(userDataRenderCmd)
+	userDataRenderCmd.Flags().StringVar(&cloud.InstanceCreationParameters.Name, "name", "", "Instance name")
+	userDataRenderCmd.Flags().StringVar(&cloud.InstanceUserDataParams.Region, "region", "", "Instance region")
+	userDataRenderCmd.MarkFlagRequired("user-data-file")
+	userDataCmd.AddCommand(userDataRenderCmd)
+
 	instanceCmd.AddCommand(&cobra.Command{
 		Use:   "delete <instance_id>",
 		Short: "Delete the given instance",
@@ -230,6 +279,31 @@ Instance billing will get back to normal and the snapshot used to store the inst
 	rebootCmd.Flags().StringVarP(&cloud.InstanceRebootType, "type", "t", "soft", "Reboot type: hard or soft (default is soft)")
 	instanceCmd.AddCommand(rebootCmd)
 
+	consoleLogCmd := &cobra.Command{
+		Use:   "console-log <instance_id>",
+		Short: "Display the serial console output of the given instance",
+		Long: `Display the serial console output of the given instance.
+
+Use --follow to keep polling the console and stream new output until interrupted, for example to debug a boot failure:
+
+	ovhcloud cloud instance console-log <instance_id> --tail 50 --follow`,
+		Run:  cloud.GetInstanceConsoleLog,
+		Args: cobra.ExactArgs(1),
+	}
+	consoleLogCmd.Flags().IntVar(&cloud.InstanceConsoleLogParams.Tail, "tail", 0, "Number of lines to display from the end of the console output (all lines by default)")
+	consoleLogCmd.Flags().BoolVar(&cloud.InstanceConsoleLogParams.Follow, "follow", false, "Keep polling the console output and display new lines")
+	consoleLogCmd.Flags().DurationVar(&cloud.InstanceConsoleLogParams.Interval, "interval", 5*time.Second, "Polling interval when following the console output")
+	instanceCmd.AddCommand(consoleLogCmd)
+
+	vncCmd := &cobra.Command{
+		Use:   "vnc <instance_id>",
+		Short: "Get the VNC console URL of the given instance",
+		Run:   cloud.GetInstanceVNC,
+		Args:  cobra.ExactArgs(1),
+	}
+	vncCmd.Flags().BoolVar(&cloud.InstanceVNCOpenBrowser, "open", false, "Open the console URL in your default browser")
+	instanceCmd.AddCommand(vncCmd)
+
 	reinstallCmd := &cobra.Command{
 		Use:   "reinstall <instance_id>",
 		Short: "Reinstall the given instance",
@@ -332,6 +406,13 @@ There are three ways to define the installation parameters:
 		Args:  cobra.ExactArgs(2),
 	})
 
+	interfacesCommand.AddCommand(&cobra.Command{
+		Use:   "set-security-groups <instance_id> <interface_id> <group_id>...",
+		Short: "Replace the security groups applied to the given interface",
+		Run:   cloud.SetInstanceInterfaceSecurityGroups,
+		Args:  cobra.MinimumNArgs(3),
+	})
+
 	enableRescueCmd := &cobra.Command{
 		Use:   "reboot-rescue <instance_id>",
 		Short: "Reboot the given instance in rescue mode",
@@ -361,6 +442,70 @@ There are three ways to define the installation parameters:
 	setFlavorCmd.Flags().BoolVar(&cloud.InstanceFlavorViaInteractiveSelector, "flavor-selector", false, "Use the interactive flavor selector")
 	instanceCmd.AddCommand(setFlavorCmd)
 
+	cloneCmd := &cobra.Command{
+		Use:   "clone <instance_id>",
+		Short: "Clone the given instance, optionally in another region",
+		Long: `Clone the given instance, optionally in another region.
+
+The following steps are performed:
+  1. A snapshot of the instance is created (directly copied to the target region if different from the source one,
+     in which case --delete-snapshot removes it from both regions)
+  2. Once the snapshot is active, a new instance is created from it, with the same flavor, SSH key,
+     public network and private networks as the source instance
+  3. Volumes attached to the source instance are copied and attached to the new instance. In the same
+     region, their data is copied using volume snapshots. In another region, empty volumes with the same
+     size and type are created.
+
+Private networks must already be available in the target region.
+
+Example:
+	ovhcloud cloud instance clone <instance_id> --region SBG5 --name staging-web --flavor b3-8`,
+		Run:  cloud.CloneInstance,
+		Args: cobra.ExactArgs(1),
+	}
+	cloneCmd.Flags().StringVar(&cloud.InstanceCloneParams.Region, "region", "", "Region of the new instance (defaults to the region of the source instance)")
+	cloneCmd.Flags().StringVar(&cloud.InstanceCloneParams.Name, "name", "", "Name of the new instance (defaults to <source_name>-clone)")
+	cloneCmd.Flags().StringVar(&cloud.InstanceCloneParams.Flavor, "flavor", "", "Flavor name of the new instance (defaults to the flavor of the source instance)")
+	cloneCmd.Flags().StringVar(&cloud.InstanceCloneParams.SnapshotName, "snapshot-name", "", "Name of the intermediate snapshot (defaults to <source_name>-clone-<date>)")
+	cloneCmd.Flags().BoolVar(&cloud.InstanceCloneParams.DeleteSnapshot, "delete-snapshot", false, "Delete the intermediate snapshot once the new instance is created")
+	instanceCmd.AddCommand(cloneCmd)
+
+	backupPolicyCmd := &cobra.Command{
+		Use:   "backup-policy",
+		Short: "Manage scheduled backups of the given instance",
+	}
+	instanceCmd.AddCommand(backupPolicyCmd)
+
+	backupPolicySetCmd := &cobra.Command{
+		Use:   "set <instance_id>",
+		Short: "Define the scheduled backups of the given instance",
+		Long: `Define the scheduled backups of the given instance, replacing the existing policy if any.
+
+Example to take a snapshot every day at 3am and keep the last 7 ones:
+	ovhcloud cloud instance backup-policy set <instance_id> --cron "0 3 * * *" --rotation 7`,
+		Run:  cloud.SetInstanceBackupPolicy,
+		Args: cobra.ExactArgs(1),
+	}
+	backupPolicySetCmd.Flags().StringVar(&cloud.InstanceBackupPolicySpec.Cron, "cron", "", "Schedule of the backups, as a cron expression (e.g. \"0 3 * * *\")")
+	backupPolicySetCmd.Flags().IntVar(&cloud.InstanceBackupPolicySpec.Rotation, "rotation", 7, "Number of backups to keep")
+	backupPolicySetCmd.Flags().StringVar(&cloud.InstanceBackupPolicySpec.Name, "name", "", "Name of the backup policy (defaults to autobackup-<instance_name>)")
+	backupPolicySetCmd.MarkFlagRequired("cron")
+	backupPolicyCmd.AddCommand(backupPolicySetCmd)
+
+	backupPolicyCmd.AddCommand(&cobra.Command{
+		Use:   "get <instance_id>",
+		Short: "Get the scheduled backups of the given instance",
+		Run:   cloud.GetInstanceBackupPolicy,
+		Args:  cobra.ExactArgs(1),
+	})
+
+	backupPolicyCmd.AddCommand(&cobra.Command{
+		Use:   "delete <instance_id>",
+		Short: "Delete the scheduled backups of the given instance",
+		Run:   cloud.DeleteInstanceBackupPolicy,
+		Args:  cobra.ExactArgs(1),
+	})
+
 	snapshotCmd := &cobra.Command{
 		Use:   "snapshot",
 		Short: "Manage snapshots of the given instance",
@@ -400,6 +545,28 @@ There are three ways to define the installation parameters:
 		Args:  cobra.ExactArgs(1),
 	})
 
+	snapshotPruneCmd := &cobra.Command{
+		Use:   "prune",
+		Short: "Delete old instance snapshots in the current cloud project",
+		Long: `Delete old instance snapshots in the current cloud project.
+
+Snapshots are first selected using the --filter flags, then sorted by creation date. The --keep-last most
+recent selected snapshots are kept, and among the others, only those created before --older-than are deleted.
+Snapshots do not reference their instance, so --keep-last applies to all the selected snapshots: filter on a
+name prefix specific to each instance to keep the last snapshots of a single instance.
+Use --dry-run to preview the snapshots that would be deleted.
+
+Examples:
+	ovhcloud cloud instance snapshot prune --filter 'name=~"^autobackup-web-1"' --keep-last 7 --dry-run
+	ovhcloud cloud instance snapshot prune --filter 'region="GRA11"' --older-than 30d`,
+		Run:  cloud.PruneInstanceSnapshots,
+		Args: cobra.NoArgs,
+	}
+	snapshotPruneCmd.Flags().IntVar(&cloud.InstanceSnapshotPruneParams.KeepLast, "keep-last", 0, "Number of most recent selected snapshots to keep, across all instances")
+	snapshotPruneCmd.Flags().StringVar(&cloud.InstanceSnapshotPruneParams.OlderThan, "older-than", "", "Only delete snapshots older than the given duration (e.g. 30d, 2w, 12h)")
+	snapshotPruneCmd.Flags().BoolVar(&cloud.InstanceSnapshotPruneParams.DryRun, "dry-run", false, "Only display the snapshots that would be deleted")
+	snapshotCmd.AddCommand(withFilterFlag(snapshotPruneCmd))
+
 	snapshotCmd.AddCommand(&cobra.Command{
 		Use:   "delete <snapshot_id>",
 		Short: "Delete a specific instance snapshot in the current cloud project",
@@ -409,3 +576,8 @@ There are three ways to define the installation parameters:
 
 	cloudCmd.AddCommand(instanceCmd)
 }
+
+func addInstanceUserDataFlags(cmd *cobra.Command) {
+	cmd.Flags().StringArrayVar(&cloud.InstanceUserDataParams.Files, "user-data-file", nil, "User data file (cloud-config, shell script…) rendered as a Go template, can be repeated to build a multipart user data")
+	cmd.Flags().StringToStringVar(&cloud.InstanceUserDataParams.Vars, "user-data-var", nil, "Custom variables available in user data templates as {{.Vars.key}} (key=value)")
+}
@@ -0,0 +1,63 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"strings"
+
+	"github.com/ovh/ovhcloud-cli/internal/services/cloud"
+	"github.com/spf13/cobra"
+)
+
+func initInstanceGroupCommand(cloudCmd *cobra.Command) {
+	instanceGroupCmd := &cobra.Command{
+		Use:   "instance-group",
+		Short: "Manage instance groups (affinity and anti-affinity policies) in the given cloud project",
+	}
+	instanceGroupCmd.PersistentFlags().StringVar(&cloud.CloudProject, "cloud-project", "", "Cloud project ID")
+
+	instanceGroupListCmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "List your instance groups",
+		Run:     cloud.ListInstanceGroups,
+	}
+	instanceGroupCmd.AddCommand(withFilterFlag(instanceGroupListCmd))
+
+	instanceGroupCmd.AddCommand(&cobra.Command{
+		Use:   "get <group_id>",
+		Short: "Get a specific instance group",
+		Run:   cloud.GetInstanceGroup,
+		Args:  cobra.ExactArgs(1),
+	})
+
+	instanceGroupCreateCmd := &cobra.Command{
+		Use:   "create <region> <name>",
+		Short: "Create a new instance group",
+		Long: `Create a new instance group in the given region.
+
+Instances created in the group are placed according to the group policy:
+  - affinity: instances are placed on the same host
+  - anti-affinity: instances are placed on different hosts, creation fails if it is not possible
+  - soft-anti-affinity: instances are placed on different hosts when possible
+
+Example:
+	ovhcloud cloud instance-group create GRA11 web-servers --policy anti-affinity
+	ovhcloud cloud instance create GRA11 --group <group_id> …`,
+		Run:  cloud.CreateInstanceGroup,
+		Args: cobra.ExactArgs(2),
+	}
+	instanceGroupCreateCmd.Flags().StringVar(&cloud.InstanceGroupPolicy, "policy", "anti-affinity", "Placement policy ("+strings.Join(cloud.InstanceGroupPolicies, ", ")+")")
+	instanceGroupCmd.AddCommand(instanceGroupCreateCmd)
+
+	instanceGroupCmd.AddCommand(&cobra.Command{
+		Use:   "delete <group_id>",
+		Short: "Delete the given instance group",
+		Run:   cloud.DeleteInstanceGroup,
+		Args:  cobra.ExactArgs(1),
+	})
+
+	cloudCmd.AddCommand(instanceGroupCmd)
+}
@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd_test
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/maxatome/go-testdeep/td"
+	"github.com/maxatome/tdhttpmock"
+	"github.com/ovh/ovhcloud-cli/internal/cmd"
+)
+
+func (ms *MockSuite) TestCloudInstanceGroupCreateCmd(assert, require *td.T) {
+	httpmock.RegisterMatcherResponder(http.MethodPost,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/instance/group",
+		tdhttpmock.JSONBody(td.JSON(`
+			{
+				"name": "web-servers",
+				"policy": "soft-anti-affinity",
+				"region": "GRA11"
+			}`),
+		),
+		httpmock.NewStringResponder(200, `{"id": "fakeGroupID", "name": "web-servers", "region": "GRA11", "type": "soft-anti-affinity", "instance_ids": []}`),
+	)
+
+	out, err := cmd.Execute("cloud", "instance-group", "create", "GRA11", "web-servers", "--policy", "soft-anti-affinity", "--cloud-project", "fakeProjectID", "--json")
+	require.CmpNoError(err)
+	assert.Cmp(json.RawMessage(out), td.JSON(`{
+		"message": "✅ Instance group web-servers created successfully (ID: fakeGroupID)",
+		"details": {"id": "fakeGroupID"}
+	}`))
+}
+
+func (ms *MockSuite) TestCloudInstanceCreateWithGroupCreateCmd(assert, require *td.T) {
+	httpmock.RegisterMatcherResponder(http.MethodPost,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/instance/group",
+		tdhttpmock.JSONBody(td.JSON(`
+			{
+				"name": "web-servers",
+				"policy": "anti-affinity",
+				"region": "GRA11"
+			}`),
+		),
+		httpmock.NewStringResponder(200, `{"id": "fakeGroupID"}`),
+	)
+
+	httpmock.RegisterMatcherResponder(http.MethodPost,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/GRA11/instance",
+		tdhttpmock.JSONBody(td.SuperJSONOf(`
+			{
+				"name": "web-1",
+				"flavor": {"id": "fakeFlavorID"},
+				"bootFrom": {"imageId": "fakeImageID"},
+				"group": {"id": "fakeGroupID"},
+				"network": {"public": true}
+			}`),
+		),
+		httpmock.NewStringResponder(200, `{"id": "fakeOperationID"}`),
+	)
+
+	out, err := cmd.Execute("cloud", "instance", "create", "GRA11", "--name", "web-1", "--flavor", "fakeFlavorID",
+		"--boot-from.image", "fakeImageID", "--network.public", "--group-create", "web-servers", "--cloud-project", "fakeProjectID", "--json")
+	require.CmpNoError(err)
+	assert.Cmp(json.RawMessage(out), td.JSON(`{"message": "⚡️ Instance creation started"}`))
+}
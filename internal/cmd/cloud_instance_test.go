@@ -5,10 +5,14 @@
 package cmd_test
 
 import (
+	"encoding/json"
 	"net/http"
+	"os"
+	"path/filepath"
 
 	"github.com/jarcoal/httpmock"
 	"github.com/maxatome/go-testdeep/td"
+	"github.com/maxatome/tdhttpmock"
 	"github.com/ovh/ovhcloud-cli/internal/cmd"
 )
 
@@ -124,3 +128,451 @@ func (ms *MockSuite) TestCloudInstanceNullImageCmd(assert, require *td.T) {
 
 `)
 }
+
+func (ms *MockSuite) TestCloudInstanceUserDataRenderCmd(assert, require *td.T) {
+	dir := assert.TempDir()
+
+	cloudConfig := filepath.Join(dir, "cloud-config.yaml")
+	require.CmpNoError(os.WriteFile(cloudConfig, []byte(`#cloud-config
+hostname: {{.Name}}
+packages:
+  - nginx
+`), 0o600))
+
+	script := filepath.Join(dir, "setup.sh")
+	require.CmpNoError(os.WriteFile(script, []byte(`#!/bin/sh
+echo "{{.Project}} {{.Region}} {{.Vars.env}}" > /etc/motd
+`), 0o600))
+
+	out, err := cmd.Execute("cloud", "instance", "user-data", "render",
+		"--user-data-file", cloudConfig, "--user-data-file", script, "--user-data-var", "env=prod",
+		"--name", "web-1", "--region", "GRA11", "--cloud-project", "fakeProjectID", "--json")
+	require.CmpNoError(err)
+
+	expected := "Content-Type: multipart/mixed; boundary=\"==OVHCLOUD-CLI-USER-DATA-BOUNDARY==\"\n" +
+		"MIME-Version: 1.0\n\n" +
+		"--==OVHCLOUD-CLI-USER-DATA-BOUNDARY==\r\n" +
+		"Content-Disposition: attachment; filename=\"cloud-config.yaml\"\r\n" +
+		"Content-Transfer-Encoding: 7bit\r\n" +
+		"Content-Type: text/cloud-config; charset=\"utf-8\"\r\n" +
+		"Mime-Version: 1.0\r\n\r\n" +
+		"#cloud-config\nhostname: web-1\npackages:\n  - nginx\n" +
+		"\r\n--==OVHCLOUD-CLI-USER-DATA-BOUNDARY==\r\n" +
+		"Content-Disposition: attachment; filename=\"setup.sh\"\r\n" +
+		"Content-Transfer-Encoding: 7bit\r\n" +
+		"Content-Type: text/x-shellscript; charset=\"utf-8\"\r\n" +
+		"Mime-Version: 1.0\r\n\r\n" +
+		"#!/bin/sh\necho \"fakeProjectID GRA11 prod\" > /etc/motd\n" +
+		"\r\n--==OVHCLOUD-CLI-USER-DATA-BOUNDARY==--\r\n"
+
+	assert.Cmp(json.RawMessage(out), td.JSON(`{
+		"message": $userData,
+		"details": {"userData": $userData}
+	}`, td.Tag("userData", expected)))
+}
+
+func (ms *MockSuite) TestCloudInstanceConsoleLogCmd(assert, require *td.T) {
+	httpmock.RegisterResponder(http.MethodGet,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/instance/fakeInstanceID/console",
+		httpmock.NewStringResponder(200, `"[    0.000000] Linux version 6.1.0\n[    1.234567] systemd[1]: Starting...\n[    2.345678] cloud-init: finished\n"`),
+	)
+
+	out, err := cmd.Execute("cloud", "instance", "console-log", "fakeInstanceID", "--tail", "2", "--cloud-project", "fakeProjectID")
+	require.CmpNoError(err)
+	assert.String(out, "[    1.234567] systemd[1]: Starting...\n[    2.345678] cloud-init: finished")
+}
+
+func (ms *MockSuite) TestCloudInstanceVNCCmd(assert, require *td.T) {
+	httpmock.RegisterResponder(http.MethodPost,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/instance/fakeInstanceID/vnc",
+		httpmock.NewStringResponder(200, `{"type": "novnc", "url": "https://compute.gra9.cloud.ovh.net/vnc_auto.html?token=fake"}`),
+	)
+
+	out, err := cmd.Execute("cloud", "instance", "vnc", "fakeInstanceID", "--cloud-project", "fakeProjectID", "--json")
+	require.CmpNoError(err)
+	assert.Cmp(json.RawMessage(out), td.JSON(`{
+		"message": "✅ Console URL for instance fakeInstanceID: https://compute.gra9.cloud.ovh.net/vnc_auto.html?token=fake",
+		"details": {
+			"type": "novnc",
+			"url": "https://compute.gra9.cloud.ovh.net/vnc_auto.html?token=fake"
+		}
+	}`))
+}
+
+func (ms *MockSuite) TestCloudInstanceCloneCmd(assert, require *td.T) {
+	httpmock.RegisterResponder(http.MethodGet,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/instance/fakeInstanceID",
+		httpmock.NewStringResponder(200, `{
+			"id": "fakeInstanceID",
+			"name": "prod-web",
+			"region": "GRA9",
+			"status": "ACTIVE",
+			"ipAddresses": [
+				{"ip": "1.2.3.4", "type": "public", "version": 4, "networkId": "publicNetworkID"},
+				{"ip": "10.0.0.12", "type": "private", "version": 4, "networkId": "privateNetworkID"}
+			],
+			"flavor": {"id": "fakeFlavorID", "name": "b3-8"},
+			"sshKey": {"id": "fakeSSHKeyID", "name": "deploy-key"}
+		}`),
+	)
+
+	httpmock.RegisterResponder(http.MethodGet,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/volume?region=GRA9",
+		httpmock.NewStringResponder(200, `[
+			{"id": "dataVolumeID", "name": "data", "region": "GRA9", "size": 100, "type": "high-speed", "attachedTo": ["fakeInstanceID"]},
+			{"id": "otherVolumeID", "name": "other", "region": "GRA9", "size": 10, "type": "classic", "attachedTo": ["otherInstanceID"]}
+		]`),
+	)
+
+	httpmock.RegisterMatcherResponder(http.MethodPost,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/GRA9/instance/fakeInstanceID/snapshot",
+		tdhttpmock.JSONBody(td.JSON(`{"snapshotName": "prod-web-snapshot"}`)),
+		httpmock.NewStringResponder(200, `{}`),
+	)
+
+	// A snapshot with the same name already exists and must not be used
+	httpmock.RegisterResponder(http.MethodGet,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/snapshot?region=GRA9",
+		httpmock.NewStringResponder(200, `[
+			{"id": "oldSnapshotID", "name": "prod-web-snapshot", "status": "active", "region": "GRA9"}
+		]`).Then(httpmock.NewStringResponder(200, `[
+			{"id": "oldSnapshotID", "name": "prod-web-snapshot", "status": "active", "region": "GRA9"},
+			{"id": "fakeSnapshotID", "name": "prod-web-snapshot", "status": "active", "region": "GRA9"}
+		]`)),
+	)
+
+	httpmock.RegisterResponder(http.MethodGet,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/GRA9/network/privateNetworkID/subnet",
+		httpmock.NewStringResponder(200, `[{"id": "fakeSubnetID", "cidr": "10.0.0.0/24"}]`),
+	)
+
+	httpmock.RegisterMatcherResponder(http.MethodPost,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/GRA9/instance",
+		tdhttpmock.JSONBody(td.JSON(`
+			{
+				"name": "staging-web",
+				"flavor": {"id": "fakeFlavorID"},
+				"bootFrom": {"imageId": "fakeSnapshotID"},
+				"network": {
+					"public": true,
+					"private": {
+						"network": {"id": "privateNetworkID", "subnetId": "fakeSubnetID"}
+					}
+				},
+				"sshKey": {"name": "deploy-key"}
+			}`),
+		),
+		httpmock.NewStringResponder(200, `{"id": "fakeOperationID"}`),
+	)
+
+	httpmock.RegisterResponder(http.MethodGet,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/operation/fakeOperationID",
+		httpmock.NewStringResponder(200, `{"id": "fakeOperationID", "action": "instance#create", "status": "completed", "resourceId": "newInstanceID"}`),
+	)
+
+	httpmock.RegisterResponder(http.MethodGet,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/instance/newInstanceID",
+		httpmock.NewStringResponder(200, `{"id": "newInstanceID", "status": "ACTIVE"}`),
+	)
+
+	httpmock.RegisterMatcherResponder(http.MethodPost,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/volume/dataVolumeID/snapshot",
+		tdhttpmock.JSONBody(td.JSON(`{"name": "data-clone"}`)),
+		httpmock.NewStringResponder(200, `{"id": "volumeSnapshotID", "status": "creating"}`),
+	)
+
+	httpmock.RegisterResponder(http.MethodGet,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/volume/snapshot/volumeSnapshotID",
+		httpmock.NewStringResponder(200, `{"id": "volumeSnapshotID", "status": "available"}`),
+	)
+
+	httpmock.RegisterMatcherResponder(http.MethodPost,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/volume",
+		tdhttpmock.JSONBody(td.JSON(`
+			{
+				"name": "data-clone",
+				"region": "GRA9",
+				"size": 100,
+				"type": "high-speed",
+				"snapshotId": "volumeSnapshotID"
+			}`),
+		),
+		httpmock.NewStringResponder(200, `{"id": "clonedVolumeID", "status": "creating"}`),
+	)
+
+	httpmock.RegisterResponder(http.MethodGet,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/volume/clonedVolumeID",
+		httpmock.NewStringResponder(200, `{"id": "clonedVolumeID", "status": "available"}`),
+	)
+
+	httpmock.RegisterMatcherResponder(http.MethodPost,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/volume/clonedVolumeID/attach",
+		tdhttpmock.JSONBody(td.JSON(`{"instanceId": "newInstanceID"}`)),
+		httpmock.NewStringResponder(200, `{}`),
+	)
+
+	out, err := cmd.Execute("cloud", "instance", "clone", "fakeInstanceID", "--name", "staging-web",
+		"--snapshot-name", "prod-web-snapshot", "--cloud-project", "fakeProjectID", "--json")
+	require.CmpNoError(err)
+	assert.Cmp(json.RawMessage(out), td.JSON(`{
+		"message": "✅ Instance fakeInstanceID cloned successfully as newInstanceID in region GRA9",
+		"details": {
+			"id": "newInstanceID",
+			"region": "GRA9",
+			"snapshotId": "fakeSnapshotID",
+			"networks": ["privateNetworkID"],
+			"volumes": ["clonedVolumeID"]
+		}
+	}`))
+}
+
+func (ms *MockSuite) TestCloudInstanceCloneCmdOtherRegion(assert, require *td.T) {
+	httpmock.RegisterResponder(http.MethodGet,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/instance/fakeInstanceID",
+		httpmock.NewStringResponder(200, `{
+			"id": "fakeInstanceID",
+			"name": "prod-web",
+			"region": "GRA9",
+			"status": "ACTIVE",
+			"ipAddresses": [
+				{"ip": "1.2.3.4", "type": "public", "version": 4, "networkId": "publicNetworkID"},
+				{"ip": "10.0.0.12", "type": "private", "version": 4, "networkId": "backNetworkGRA9"},
+				{"ip": "10.1.0.12", "type": "private", "version": 4, "networkId": "adminNetworkGRA9"}
+			],
+			"flavor": {"id": "fakeFlavorID", "name": "b3-8"}
+		}`),
+	)
+
+	httpmock.RegisterResponder(http.MethodGet,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/flavor?region=SBG5",
+		httpmock.NewStringResponder(200, `[
+			{"id": "flavorB38SBG5", "name": "b3-8"},
+			{"id": "flavorB316SBG5", "name": "b3-16"}
+		]`),
+	)
+
+	httpmock.RegisterResponder(http.MethodGet,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/network/private",
+		httpmock.NewStringResponder(200, `[
+			{"id": "pn-back", "regions": [{"region": "GRA9", "openstackId": "backNetworkGRA9"}, {"region": "SBG5", "openstackId": "backNetworkSBG5"}]},
+			{"id": "pn-admin", "regions": [{"region": "GRA9", "openstackId": "adminNetworkGRA9"}, {"region": "SBG5", "openstackId": "adminNetworkSBG5"}]}
+		]`),
+	)
+
+	httpmock.RegisterResponder(http.MethodGet,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/volume?region=GRA9",
+		httpmock.NewStringResponder(200, `[]`),
+	)
+
+	httpmock.RegisterMatcherResponder(http.MethodPost,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/GRA9/instance/fakeInstanceID/snapshot",
+		tdhttpmock.JSONBody(td.JSON(`
+			{
+				"snapshotName": "prod-web-snapshot",
+				"distantSnapshotName": "prod-web-snapshot",
+				"distantRegionName": "SBG5"
+			}`),
+		),
+		httpmock.NewStringResponder(200, `{}`),
+	)
+
+	httpmock.RegisterResponder(http.MethodGet,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/snapshot?region=SBG5",
+		httpmock.NewStringResponder(200, `[]`).Then(httpmock.NewStringResponder(200, `[
+			{"id": "distantSnapshotID", "name": "prod-web-snapshot", "status": "active", "region": "SBG5"}
+		]`)),
+	)
+
+	// A snapshot with the same name already exists in the source region and must not be deleted
+	httpmock.RegisterResponder(http.MethodGet,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/snapshot?region=GRA9",
+		httpmock.NewStringResponder(200, `[
+			{"id": "oldSnapshotID", "name": "prod-web-snapshot", "status": "active", "region": "GRA9"}
+		]`).Then(httpmock.NewStringResponder(200, `[
+			{"id": "oldSnapshotID", "name": "prod-web-snapshot", "status": "active", "region": "GRA9"},
+			{"id": "sourceSnapshotID", "name": "prod-web-snapshot", "status": "active", "region": "GRA9"}
+		]`)),
+	)
+
+	httpmock.RegisterResponder(http.MethodGet,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/SBG5/network/backNetworkSBG5/subnet",
+		httpmock.NewStringResponder(200, `[{"id": "backSubnetSBG5", "cidr": "10.0.0.0/24"}]`),
+	)
+
+	httpmock.RegisterMatcherResponder(http.MethodPost,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/SBG5/instance",
+		tdhttpmock.JSONBody(td.JSON(`
+			{
+				"name": "staging-web",
+				"flavor": {"id": "flavorB316SBG5"},
+				"bootFrom": {"imageId": "distantSnapshotID"},
+				"network": {
+					"public": true,
+					"private": {
+						"network": {"id": "backNetworkSBG5", "subnetId": "backSubnetSBG5"}
+					}
+				}
+			}`),
+		),
+		httpmock.NewStringResponder(200, `{"id": "fakeOperationID"}`),
+	)
+
+	httpmock.RegisterResponder(http.MethodGet,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/operation/fakeOperationID",
+		httpmock.NewStringResponder(200, `{"id": "fakeOperationID", "action": "instance#create", "status": "completed", "resourceId": "newInstanceID"}`),
+	)
+
+	httpmock.RegisterResponder(http.MethodGet,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/instance/newInstanceID",
+		httpmock.NewStringResponder(200, `{"id": "newInstanceID", "status": "ACTIVE"}`),
+	)
+
+	httpmock.RegisterMatcherResponder(http.MethodPost,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/instance/newInstanceID/interface",
+		tdhttpmock.JSONBody(td.JSON(`{"networkId": "adminNetworkSBG5"}`)),
+		httpmock.NewStringResponder(200, `{}`),
+	)
+
+	// The snapshot must be deleted in both regions
+	httpmock.RegisterResponder(http.MethodDelete,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/snapshot/distantSnapshotID",
+		httpmock.NewStringResponder(200, ``),
+	)
+	httpmock.RegisterResponder(http.MethodDelete,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/snapshot/sourceSnapshotID",
+		httpmock.NewStringResponder(200, ``),
+	)
+
+	out, err := cmd.Execute("cloud", "instance", "clone", "fakeInstanceID", "--region", "SBG5", "--name", "staging-web", "--flavor", "b3-16",
+		"--snapshot-name", "prod-web-snapshot", "--delete-snapshot", "--cloud-project", "fakeProjectID", "--json")
+	require.CmpNoError(err)
+	assert.Cmp(json.RawMessage(out), td.JSON(`{
+		"message": "✅ Instance fakeInstanceID cloned successfully as newInstanceID in region SBG5",
+		"details": {
+			"id": "newInstanceID",
+			"region": "SBG5",
+			"networks": ["backNetworkSBG5", "adminNetworkSBG5"],
+			"volumes": null
+		}
+	}`))
+
+	info := httpmock.GetCallCountInfo()
+	assert.Cmp(info["DELETE https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/snapshot/distantSnapshotID"], 1)
+	assert.Cmp(info["DELETE https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/snapshot/sourceSnapshotID"], 1)
+}
+
+func (ms *MockSuite) TestCloudInstanceBackupPolicySetCmd(assert, require *td.T) {
+	httpmock.RegisterResponder(http.MethodGet,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/instance/fakeInstanceID",
+		httpmock.NewStringResponder(200, `{"id": "fakeInstanceID", "name": "web-1", "region": "GRA9"}`),
+	)
+
+	httpmock.RegisterResponder(http.MethodGet,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/GRA9/workflow/backup",
+		httpmock.NewStringResponder(200, `[
+			{"id": "oldWorkflowID", "instanceId": "fakeInstanceID", "cron": "0 1 * * *"},
+			{"id": "otherWorkflowID", "instanceId": "otherInstanceID", "cron": "0 2 * * *"}
+		]`),
+	)
+
+	// The old workflow must only be deleted once the new one is created
+	var calls []string
+	httpmock.RegisterResponder(http.MethodDelete,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/GRA9/workflow/backup/oldWorkflowID",
+		func(*http.Request) (*http.Response, error) {
+			calls = append(calls, "delete")
+			return httpmock.NewStringResponse(200, ``), nil
+		},
+	)
+
+	httpmock.RegisterMatcherResponder(http.MethodPost,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/GRA9/workflow/backup",
+		tdhttpmock.JSONBody(td.JSON(`
+			{
+				"cron": "0 3 * * *",
+				"instanceId": "fakeInstanceID",
+				"name": "autobackup-web-1",
+				"rotation": 7
+			}`),
+		),
+		func(*http.Request) (*http.Response, error) {
+			calls = append(calls, "create")
+			return httpmock.NewStringResponse(200, `{"id": "newWorkflowID", "instanceId": "fakeInstanceID", "cron": "0 3 * * *", "name": "autobackup-web-1"}`), nil
+		},
+	)
+
+	out, err := cmd.Execute("cloud", "instance", "backup-policy", "set", "fakeInstanceID", "--cron", "0 3 * * *", "--rotation", "7", "--cloud-project", "fakeProjectID", "--json")
+	require.CmpNoError(err)
+	assert.Cmp(json.RawMessage(out), td.JSON(`{
+		"message": "✅ Backup policy of instance fakeInstanceID set (cron: 0 3 * * *, rotation: 7)",
+		"details": {
+			"id": "newWorkflowID",
+			"instanceId": "fakeInstanceID",
+			"cron": "0 3 * * *",
+			"name": "autobackup-web-1"
+		}
+	}`))
+	assert.Cmp(calls, []string{"create", "delete"})
+}
+
+func (ms *MockSuite) TestCloudInstanceSnapshotPruneCmd(assert, require *td.T) {
+	httpmock.RegisterResponder(http.MethodGet,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/snapshot",
+		httpmock.NewStringResponder(200, `[
+			{"id": "snapshot1", "name": "autobackup-web-1-1", "region": "GRA9", "creationDate": "2025-01-01T03:00:00Z", "size": 2},
+			{"id": "snapshot2", "name": "autobackup-web-1-2", "region": "GRA9", "creationDate": "2025-01-02T03:00:00Z", "size": 2},
+			{"id": "snapshot3", "name": "autobackup-web-1-3", "region": "GRA9", "creationDate": "2025-01-03T03:00:00Z", "size": 2},
+			{"id": "snapshot4", "name": "manual-db-1", "region": "GRA9", "creationDate": "2024-06-01T10:00:00Z", "size": 5}
+		]`),
+	)
+
+	httpmock.RegisterResponder(http.MethodDelete,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/snapshot/snapshot1",
+		httpmock.NewStringResponder(200, ``),
+	)
+
+	out, err := cmd.Execute("cloud", "instance", "snapshot", "prune", "--filter", `name=~"^autobackup-web-1"`,
+		"--keep-last", "2", "--older-than", "30d", "--cloud-project", "fakeProjectID", "--json")
+	require.CmpNoError(err)
+	assert.Cmp(json.RawMessage(out), td.JSON(`{
+		"message": "✅ 1 snapshot(s) deleted",
+		"details": {"deleted": ["snapshot1"]}
+	}`))
+}
+
+func (ms *MockSuite) TestCloudInstanceCreateUserDataNameFromFileCmd(assert, require *td.T) {
+	dir := assert.TempDir()
+
+	params := filepath.Join(dir, "params.json")
+	require.CmpNoError(os.WriteFile(params, []byte(`{
+		"name": "web-1",
+		"flavor": {"id": "fakeFlavorID"},
+		"bootFrom": {"imageId": "fakeImageID"},
+		"network": {"public": true}
+	}`), 0o600))
+
+	cloudConfig := filepath.Join(dir, "cloud-config.yaml")
+	require.CmpNoError(os.WriteFile(cloudConfig, []byte(`#cloud-config
+hostname: {{.Name}}
+`), 0o600))
+
+	httpmock.RegisterMatcherResponder(http.MethodPost,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/GRA11/instance",
+		tdhttpmock.JSONBody(td.JSON(`
+			{
+				"name": "web-1",
+				"billingPeriod": "hourly",
+				"flavor": {"id": "fakeFlavorID"},
+				"bootFrom": {"imageId": "fakeImageID"},
+				"network": {"public": true},
+				"userData": "#cloud-config\nhostname: web-1\n"
+			}`),
+		),
+		httpmock.NewStringResponder(200, `{"id": "fakeOperationID"}`),
+	)
+
+	out, err := cmd.Execute("cloud", "instance", "create", "GRA11", "--from-file", params, "--user-data-file", cloudConfig,
+		"--cloud-project", "fakeProjectID", "--json")
+	require.CmpNoError(err)
+	assert.Cmp(json.RawMessage(out), td.JSON(`{"message": "⚡️ Instance creation started"}`))
+}
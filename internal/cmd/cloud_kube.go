@@ -327,6 +327,16 @@ There are three ways to define the creation parameters:
   Note that it is also possible to override values in the presented examples using command line flags like the following:
 
 	ovhcloud cloud kube create --editor --region BHS5
+
+4. Using the interactive wizard:
+
+	ovhcloud cloud kube create --wizard --name MyNewCluster
+
+  You will be guided through the selection of the region, version, plan, private network, subnets, gateway
+  and initial node pool. Steps corresponding to parameters already given using command line flags are skipped.
+  The wizard can be combined with the other modes, for example to save the selected parameters in a file for later reuse:
+
+	ovhcloud cloud kube create --wizard --init-file ./params.json
 `,
 		Run: cloud.CreateKube,
 	}
@@ -346,6 +356,10 @@ There are three ways to define the creation parameters:
 	kubeCreateCmd.Flags().StringVar(&cloud.KubeSpec.PrivateNetworkConfiguration.DefaultVrackGateway, "private-network.default-vrack-gateway", "", "If defined, all egress traffic will be routed towards this IP address, which should belong to the private network")
 	kubeCreateCmd.Flags().BoolVar(&cloud.KubeSpec.PrivateNetworkConfiguration.PrivateNetworkRoutingAsDefault, "private-network.routing-as-default", false, "Set private network routing as default")
 
+	// Initial node pool
+	kubeCreateCmd.Flags().StringVar(&cloud.KubeSpec.Nodepool.FlavorName, "nodepool.flavor-name", "", "Flavor of the nodes of the initial node pool")
+	kubeCreateCmd.Flags().IntVar(&cloud.KubeSpec.Nodepool.DesiredNodes, "nodepool.desired-nodes", 0, "Number of nodes of the initial node pool")
+
 	// Customization: API Server Admission Plugins
 	kubeCreateCmd.Flags().StringSliceVar(&cloud.KubeSpec.Customization.APIServer.AdmissionPlugins.Enabled, "customization.api-server.admission-plugins.enabled", nil, "Admission plugins to enable on API server (AlwaysPullImages, NodeRestriction)")
 	kubeCreateCmd.Flags().StringSliceVar(&cloud.KubeSpec.Customization.APIServer.AdmissionPlugins.Disabled, "customization.api-server.admission-plugins.disabled", nil, "Admission plugins to disable on API server (AlwaysPullImages, NodeRestriction)")
@@ -363,9 +377,12 @@ There are three ways to define the creation parameters:
 	kubeCreateCmd.Flags().StringVar(&cloud.KubeSpec.Customization.KubeProxy.IPVS.UDPTimeout, "customization.kube-proxy.ipvs.udp-timeout", "", "Timeout value used for IPVS UDP packets in RFC3339 duration format (e.g. 'PT60S')")
 
 	// Common flags for other means to define parameters
-	addInitParameterFileFlag(kubeCreateCmd, assets.CloudOpenapiSchema, "/cloud/project/{serviceName}/kube", "post", cloud.CloudKubeCreationExample, nil)
+	addInitParameterFileFlag(kubeCreateCmd, assets.CloudOpenapiSchema, "/cloud/project/{serviceName}/kube", "post", cloud.CloudKubeCreationExample, cloud.GetKubeCreationInteractiveParameters)
 	addInteractiveEditorFlag(kubeCreateCmd)
 	addFromFileFlag(kubeCreateCmd)
+	if !(runtime.GOARCH == "wasm" && runtime.GOOS == "js") {
+		kubeCreateCmd.Flags().BoolVar(&cloud.KubeCreationViaInteractiveWizard, "wizard", false, "Use the interactive wizard to define the cluster parameters")
+	}
 	kubeCreateCmd.MarkFlagsMutuallyExclusive("from-file", "editor")
 
 	return kubeCreateCmd
@@ -5,8 +5,12 @@
 package cmd_test
 
 import (
+	"encoding/json"
+	"net/http"
+
 	"github.com/jarcoal/httpmock"
 	"github.com/maxatome/go-testdeep/td"
+	"github.com/maxatome/tdhttpmock"
 	"github.com/ovh/ovhcloud-cli/internal/cmd"
 )
 
@@ -36,3 +40,58 @@ func (ms *MockSuite) TestCloudKubeListCmd(assert, require *td.T) {
 └────────────┴───────────┴────────┴──────┴─────────┴────────────┘
 💡 Use option --json or --yaml to get the raw output with all information`[1:])
 }
+
+func (ms *MockSuite) TestCloudKubeCreateWizardCmd(assert, require *td.T) {
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region",
+		httpmock.NewStringResponder(200, `["GRA11"]`))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/GRA11",
+		httpmock.NewStringResponder(200, `{
+			"name": "GRA11",
+			"type": "region",
+			"status": "UP",
+			"services": [
+				{
+					"name": "kubernetes",
+					"status": "UP"
+				}
+			]
+		}`))
+
+	// No private network in the region, so the network steps are skipped
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/network/private",
+		httpmock.NewStringResponder(200, `[
+			{"id": "pn-1", "name": "backend", "vlanId": 10, "regions": [{"region": "SBG5", "openstackId": "fakeNetworkID"}]}
+		]`))
+
+	httpmock.RegisterMatcherResponder(http.MethodPost,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/kube",
+		tdhttpmock.JSONBody(td.JSON(`
+			{
+				"name": "my-cluster",
+				"region": "GRA11",
+				"version": "1.32",
+				"plan": "free",
+				"nodepool": {
+					"flavorName": "b3-8",
+					"desiredNodes": 3
+				}
+			}`),
+		),
+		httpmock.NewStringResponder(200, `{"id": "fakeKubeID", "name": "my-cluster"}`),
+	)
+
+	// All the steps needing a choice are given using flags
+	out, err := cmd.Execute("cloud", "kube", "create", "--wizard", "--name", "my-cluster", "--region", "GRA11", "--version", "1.32",
+		"--plan", "free", "--nodepool.flavor-name", "b3-8", "--nodepool.desired-nodes", "3", "--cloud-project", "fakeProjectID", "--json")
+	require.CmpNoError(err)
+	assert.Cmp(json.RawMessage(out), td.JSON(`{
+		"message": "✅ Cluster my-cluster created successfully (id: fakeKubeID)",
+		"details": {"id": "fakeKubeID", "name": "my-cluster"}
+	}`))
+}
+
+func (ms *MockSuite) TestCloudKubeCreateKeepsInteractiveOutputFlagCmd(assert, require *td.T) {
+	_, err := cmd.Execute("cloud", "kube", "create", "--interactive", "--json", "--cloud-project", "fakeProjectID")
+	assert.String(err, `if any flags in the group [json yaml interactive format] are set none of the others can be; [interactive json] were all set`)
+}
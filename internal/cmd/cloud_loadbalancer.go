@@ -0,0 +1,356 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"strings"
+
+	"github.com/ovh/ovhcloud-cli/internal/services/cloud"
+	"github.com/spf13/cobra"
+)
+
+func initLoadbalancerListenerCommand(loadbalancerCmd *cobra.Command) {
+	listenerCmd := &cobra.Command{
+		Use:   "listener",
+		Short: "Manage listeners of the given loadbalancer",
+	}
+	loadbalancerCmd.AddCommand(listenerCmd)
+
+	listenerCmd.AddCommand(withFilterFlag(&cobra.Command{
+		Use:     "list <loadbalancer_id>",
+		Aliases: []string{"ls"},
+		Short:   "List listeners of the given loadbalancer",
+		Run:     cloud.ListCloudLoadbalancerListeners,
+		Args:    cobra.ExactArgs(1),
+	}))
+
+	listenerCmd.AddCommand(&cobra.Command{
+		Use:   "get <loadbalancer_id> <listener_id>",
+		Short: "Get a specific listener",
+		Run:   cloud.GetCloudLoadbalancerListener,
+		Args:  cobra.ExactArgs(2),
+	})
+
+	listenerCreateCmd := &cobra.Command{
+		Use:   "create <loadbalancer_id>",
+		Short: "Create a listener on the given loadbalancer",
+		Long: `Create a listener on the given loadbalancer.
+
+Example:
+	ovhcloud cloud network loadbalancer listener create <loadbalancer_id> --protocol http --port 80 --default-pool <pool_id>`,
+		Run:  cloud.CreateCloudLoadbalancerListener,
+		Args: cobra.ExactArgs(1),
+	}
+	listenerCreateCmd.Flags().StringVar(&cloud.CloudLoadbalancerListenerSpec.Name, "name", "", "Name of the listener")
+	listenerCreateCmd.Flags().StringVar(&cloud.CloudLoadbalancerListenerSpec.Description, "description", "", "Description of the listener")
+	listenerCreateCmd.Flags().StringVar(&cloud.CloudLoadbalancerListenerSpec.Protocol, "protocol", "", "Protocol of the listener ("+strings.Join(cloud.CloudLoadbalancerListenerProtocols, ", ")+")")
+	listenerCreateCmd.Flags().IntVar(&cloud.CloudLoadbalancerListenerSpec.Port, "port", 0, "Port the listener listens on")
+	listenerCreateCmd.Flags().StringVar(&cloud.CloudLoadbalancerListenerSpec.DefaultPoolID, "default-pool", "", "ID of the pool receiving the traffic by default")
+	listenerCreateCmd.MarkFlagRequired("protocol")
+	listenerCreateCmd.MarkFlagRequired("port")
+	listenerCmd.AddCommand(listenerCreateCmd)
+
+	listenerEditCmd := &cobra.Command{
+		Use:   "edit <loadbalancer_id> <listener_id>",
+		Short: "Edit the given listener",
+		Run:   cloud.EditCloudLoadbalancerListener,
+		Args:  cobra.ExactArgs(2),
+	}
+	listenerEditCmd.Flags().StringVar(&cloud.CloudLoadbalancerListenerUpdateSpec.Name, "name", "", "Name of the listener")
+	listenerEditCmd.Flags().StringVar(&cloud.CloudLoadbalancerListenerUpdateSpec.Description, "description", "", "Description of the listener")
+	listenerEditCmd.Flags().StringVar(&cloud.CloudLoadbalancerListenerUpdateSpec.DefaultPoolID, "default-pool", "", "ID of the pool receiving the traffic by default")
+	addInteractiveEditorFlag(listenerEditCmd)
+	listenerCmd.AddCommand(listenerEditCmd)
+
+	listenerCmd.AddCommand(&cobra.Command{
+		Use:   "delete <loadbalancer_id> <listener_id>",
+		Short: "Delete the given listener",
+		Run:   cloud.DeleteCloudLoadbalancerListener,
+		Args:  cobra.ExactArgs(2),
+	})
+}
+
+func initLoadbalancerPoolCommand(loadbalancerCmd *cobra.Command) {
+	poolCmd := &cobra.Command{
+		Use:   "pool",
+		Short: "Manage pools of the given loadbalancer",
+	}
+	loadbalancerCmd.AddCommand(poolCmd)
+
+	poolCmd.AddCommand(withFilterFlag(&cobra.Command{
+		Use:     "list <loadbalancer_id>",
+		Aliases: []string{"ls"},
+		Short:   "List pools of the given loadbalancer",
+		Run:     cloud.ListCloudLoadbalancerPools,
+		Args:    cobra.ExactArgs(1),
+	}))
+
+	poolCmd.AddCommand(&cobra.Command{
+		Use:   "get <loadbalancer_id> <pool_id>",
+		Short: "Get a specific pool",
+		Run:   cloud.GetCloudLoadbalancerPool,
+		Args:  cobra.ExactArgs(2),
+	})
+
+	poolCreateCmd := &cobra.Command{
+		Use:   "create <loadbalancer_id>",
+		Short: "Create a pool on the given loadbalancer",
+		Long: `Create a pool on the given loadbalancer.
+
+Example:
+	ovhcloud cloud network loadbalancer pool create <loadbalancer_id> --protocol http --algorithm roundRobin --listener <listener_id>`,
+		Run:  cloud.CreateCloudLoadbalancerPool,
+		Args: cobra.ExactArgs(1),
+	}
+	poolCreateCmd.Flags().StringVar(&cloud.CloudLoadbalancerPoolSpec.Name, "name", "", "Name of the pool")
+	poolCreateCmd.Flags().StringVar(&cloud.CloudLoadbalancerPoolSpec.Protocol, "protocol", "", "Protocol of the pool ("+strings.Join(cloud.CloudLoadbalancerPoolProtocols, ", ")+")")
+	poolCreateCmd.Flags().StringVar(&cloud.CloudLoadbalancerPoolSpec.Algorithm, "algorithm", "roundRobin", "Load balancing algorithm ("+strings.Join(cloud.CloudLoadbalancerPoolAlgorithms, ", ")+")")
+	poolCreateCmd.Flags().StringVar(&cloud.CloudLoadbalancerPoolSpec.ListenerID, "listener", "", "ID of the listener to attach the pool to")
+	poolCreateCmd.MarkFlagRequired("protocol")
+	poolCmd.AddCommand(poolCreateCmd)
+
+	poolEditCmd := &cobra.Command{
+		Use:   "edit <loadbalancer_id> <pool_id>",
+		Short: "Edit the given pool",
+		Run:   cloud.EditCloudLoadbalancerPool,
+		Args:  cobra.ExactArgs(2),
+	}
+	poolEditCmd.Flags().StringVar(&cloud.CloudLoadbalancerPoolUpdateSpec.Name, "name", "", "Name of the pool")
+	poolEditCmd.Flags().StringVar(&cloud.CloudLoadbalancerPoolUpdateSpec.Algorithm, "algorithm", "", "Load balancing algorithm ("+strings.Join(cloud.CloudLoadbalancerPoolAlgorithms, ", ")+")")
+	addInteractiveEditorFlag(poolEditCmd)
+	poolCmd.AddCommand(poolEditCmd)
+
+	poolCmd.AddCommand(&cobra.Command{
+		Use:   "delete <loadbalancer_id> <pool_id>",
+		Short: "Delete the given pool",
+		Run:   cloud.DeleteCloudLoadbalancerPool,
+		Args:  cobra.ExactArgs(2),
+	})
+}
+
+func initLoadbalancerMemberCommand(loadbalancerCmd *cobra.Command) {
+	memberCmd := &cobra.Command{
+		Use:   "member",
+		Short: "Manage members of the pools of the given loadbalancer",
+	}
+	loadbalancerCmd.AddCommand(memberCmd)
+
+	memberCmd.AddCommand(withFilterFlag(&cobra.Command{
+		Use:     "list <loadbalancer_id> <pool_id>",
+		Aliases: []string{"ls"},
+		Short:   "List members of the given pool",
+		Run:     cloud.ListCloudLoadbalancerMembers,
+		Args:    cobra.ExactArgs(2),
+	}))
+
+	memberCmd.AddCommand(&cobra.Command{
+		Use:   "get <loadbalancer_id> <pool_id> <member_id>",
+		Short: "Get a specific pool member",
+		Run:   cloud.GetCloudLoadbalancerMember,
+		Args:  cobra.ExactArgs(3),
+	})
+
+	memberAddCmd := &cobra.Command{
+		Use:   "add <loadbalancer_id> <pool_id>",
+		Short: "Add a member to the given pool",
+		Run:   cloud.AddCloudLoadbalancerMember,
+		Args:  cobra.ExactArgs(2),
+	}
+	memberAddCmd.Flags().StringVar(&cloud.CloudLoadbalancerMemberSpec.Name, "name", "", "Name of the member")
+	memberAddCmd.Flags().StringVar(&cloud.CloudLoadbalancerMemberSpec.Address, "address", "", "IP address of the member")
+	memberAddCmd.Flags().IntVar(&cloud.CloudLoadbalancerMemberSpec.ProtocolPort, "port", 0, "Port on which the member receives the traffic")
+	addLoadbalancerMemberWeightFlag(memberAddCmd, &cloud.CloudLoadbalancerMemberSpec.Weight)
+	memberAddCmd.MarkFlagRequired("address")
+	memberAddCmd.MarkFlagRequired("port")
+	memberCmd.AddCommand(memberAddCmd)
+
+	memberAddInstanceCmd := &cobra.Command{
+		Use:   "add-instance <loadbalancer_id> <pool_id> <instance_id>",
+		Short: "Add an instance to the given pool",
+		Long: `Add an instance to the given pool.
+
+The private IP of the instance is resolved automatically (first private IPv4
+address of the instance, unless --ip is given). The instance must be in the
+same region as the loadbalancer.
+
+Example:
+	ovhcloud cloud network loadbalancer member add-instance <loadbalancer_id> <pool_id> <instance_id> --port 8080`,
+		Run:  cloud.AddCloudLoadbalancerInstanceMember,
+		Args: cobra.ExactArgs(3),
+	}
+	memberAddInstanceCmd.Flags().StringVar(&cloud.CloudLoadbalancerMemberSpec.Name, "name", "", "Name of the member (defaults to the instance ID)")
+	memberAddInstanceCmd.Flags().StringVar(&cloud.CloudLoadbalancerMemberInstanceIP, "ip", "", "Private IP of the instance to use (defaults to its first private IPv4)")
+	memberAddInstanceCmd.Flags().IntVar(&cloud.CloudLoadbalancerMemberSpec.ProtocolPort, "port", 0, "Port on which the instance receives the traffic")
+	addLoadbalancerMemberWeightFlag(memberAddInstanceCmd, &cloud.CloudLoadbalancerMemberSpec.Weight)
+	memberAddInstanceCmd.MarkFlagRequired("port")
+	memberCmd.AddCommand(memberAddInstanceCmd)
+
+	memberEditCmd := &cobra.Command{
+		Use:   "edit <loadbalancer_id> <pool_id> <member_id>",
+		Short: "Edit the given pool member",
+		Run:   cloud.EditCloudLoadbalancerMember,
+		Args:  cobra.ExactArgs(3),
+	}
+	memberEditCmd.Flags().StringVar(&cloud.CloudLoadbalancerMemberUpdateSpec.Name, "name", "", "Name of the member")
+	addLoadbalancerMemberWeightFlag(memberEditCmd, &cloud.CloudLoadbalancerMemberUpdateSpec.Weight)
+	addInteractiveEditorFlag(memberEditCmd)
+	memberCmd.AddCommand(memberEditCmd)
+
+	memberCmd.AddCommand(&cobra.Command{
+		Use:   "delete <loadbalancer_id> <pool_id> <member_id>",
+		Short: "Remove the given member from its pool",
+		Run:   cloud.DeleteCloudLoadbalancerMember,
+		Args:  cobra.ExactArgs(3),
+	})
+}
+
+func initLoadbalancerHealthMonitorCommand(loadbalancerCmd *cobra.Command) {
+	healthMonitorCmd := &cobra.Command{
+		Use:   "health-monitor",
+		Short: "Manage health monitors of the given loadbalancer",
+	}
+	loadbalancerCmd.AddCommand(healthMonitorCmd)
+
+	healthMonitorCmd.AddCommand(withFilterFlag(&cobra.Command{
+		Use:     "list <loadbalancer_id>",
+		Aliases: []string{"ls"},
+		Short:   "List health monitors of the given loadbalancer",
+		Run:     cloud.ListCloudLoadbalancerHealthMonitors,
+		Args:    cobra.ExactArgs(1),
+	}))
+
+	healthMonitorCmd.AddCommand(&cobra.Command{
+		Use:   "get <loadbalancer_id> <health_monitor_id>",
+		Short: "Get a specific health monitor",
+		Run:   cloud.GetCloudLoadbalancerHealthMonitor,
+		Args:  cobra.ExactArgs(2),
+	})
+
+	healthMonitorCreateCmd := &cobra.Command{
+		Use:   "create <loadbalancer_id> <pool_id>",
+		Short: "Create a health monitor on the given pool",
+		Long: `Create a health monitor on the given pool.
+
+Example:
+	ovhcloud cloud network loadbalancer health-monitor create <loadbalancer_id> <pool_id> --type http --url-path /healthz --expected-codes 200`,
+		Run:  cloud.CreateCloudLoadbalancerHealthMonitor,
+		Args: cobra.ExactArgs(2),
+	}
+	healthMonitorCreateCmd.Flags().StringVar(&cloud.CloudLoadbalancerHealthMonitorSpec.Name, "name", "", "Name of the health monitor")
+	healthMonitorCreateCmd.Flags().StringVar(&cloud.CloudLoadbalancerHealthMonitorSpec.MonitorType, "type", "", "Type of the health monitor ("+strings.Join(cloud.CloudLoadbalancerHealthMonitorTypes, ", ")+")")
+	healthMonitorCreateCmd.Flags().IntVar(&cloud.CloudLoadbalancerHealthMonitorSpec.Delay, "delay", 5, "Time between probes, in seconds")
+	healthMonitorCreateCmd.Flags().IntVar(&cloud.CloudLoadbalancerHealthMonitorSpec.Timeout, "timeout", 3, "Maximum time a probe waits for a response, in seconds")
+	healthMonitorCreateCmd.Flags().IntVar(&cloud.CloudLoadbalancerHealthMonitorSpec.MaxRetries, "max-retries", 3, "Number of successful probes before a member is considered healthy")
+	healthMonitorCreateCmd.Flags().IntVar(&cloud.CloudLoadbalancerHealthMonitorSpec.MaxRetriesDown, "max-retries-down", 0, "Number of failed probes before a member is considered unhealthy")
+	healthMonitorCreateCmd.Flags().StringVar(&cloud.CloudLoadbalancerHealthMonitorHTTP.URLPath, "url-path", "", "Path requested by HTTP probes")
+	healthMonitorCreateCmd.Flags().StringVar(&cloud.CloudLoadbalancerHealthMonitorHTTP.HTTPMethod, "http-method", "", "Method used by HTTP probes (e.g. GET, HEAD)")
+	healthMonitorCreateCmd.Flags().StringVar(&cloud.CloudLoadbalancerHealthMonitorHTTP.ExpectedCodes, "expected-codes", "", "HTTP status codes expected from healthy members (e.g. 200, 200-204 or 200,301)")
+	healthMonitorCreateCmd.MarkFlagRequired("type")
+	healthMonitorCmd.AddCommand(healthMonitorCreateCmd)
+
+	healthMonitorEditCmd := &cobra.Command{
+		Use:   "edit <loadbalancer_id> <health_monitor_id>",
+		Short: "Edit the given health monitor",
+		Run:   cloud.EditCloudLoadbalancerHealthMonitor,
+		Args:  cobra.ExactArgs(2),
+	}
+	healthMonitorEditCmd.Flags().StringVar(&cloud.CloudLoadbalancerHealthMonitorUpdateSpec.Name, "name", "", "Name of the health monitor")
+	healthMonitorEditCmd.Flags().IntVar(&cloud.CloudLoadbalancerHealthMonitorUpdateSpec.Delay, "delay", 0, "Time between probes, in seconds")
+	healthMonitorEditCmd.Flags().IntVar(&cloud.CloudLoadbalancerHealthMonitorUpdateSpec.Timeout, "timeout", 0, "Maximum time a probe waits for a response, in seconds")
+	healthMonitorEditCmd.Flags().IntVar(&cloud.CloudLoadbalancerHealthMonitorUpdateSpec.MaxRetries, "max-retries", 0, "Number of successful probes before a member is considered healthy")
+	healthMonitorEditCmd.Flags().IntVar(&cloud.CloudLoadbalancerHealthMonitorUpdateSpec.MaxRetriesDown, "max-retries-down", 0, "Number of failed probes before a member is considered unhealthy")
+	addInteractiveEditorFlag(healthMonitorEditCmd)
+	healthMonitorCmd.AddCommand(healthMonitorEditCmd)
+
+	healthMonitorCmd.AddCommand(&cobra.Command{
+		Use:   "delete <loadbalancer_id> <health_monitor_id>",
+		Short: "Delete the given health monitor",
+		Run:   cloud.DeleteCloudLoadbalancerHealthMonitor,
+		Args:  cobra.ExactArgs(2),
+	})
+}
+
+func initLoadbalancerL7PolicyCommand(loadbalancerCmd *cobra.Command) {
+	l7PolicyCmd := &cobra.Command{
+		Use:   "l7policy",
+		Short: "Manage L7 policies of the given loadbalancer",
+	}
+	loadbalancerCmd.AddCommand(l7PolicyCmd)
+
+	l7PolicyCmd.AddCommand(withFilterFlag(&cobra.Command{
+		Use:     "list <loadbalancer_id>",
+		Aliases: []string{"ls"},
+		Short:   "List L7 policies of the given loadbalancer",
+		Run:     cloud.ListCloudLoadbalancerL7Policies,
+		Args:    cobra.ExactArgs(1),
+	}))
+
+	l7PolicyCmd.AddCommand(&cobra.Command{
+		Use:   "get <loadbalancer_id> <l7policy_id>",
+		Short: "Get a specific L7 policy",
+		Run:   cloud.GetCloudLoadbalancerL7Policy,
+		Args:  cobra.ExactArgs(2),
+	})
+
+	l7PolicyCreateCmd := &cobra.Command{
+		Use:   "create <loadbalancer_id> <listener_id>",
+		Short: "Create an L7 policy on the given listener",
+		Long: `Create an L7 policy on the given listener.
+
+Example:
+	ovhcloud cloud network loadbalancer l7policy create <loadbalancer_id> <listener_id> --action redirectToURL --redirect-url https://example.com --redirect-http-code 301`,
+		Run:  cloud.CreateCloudLoadbalancerL7Policy,
+		Args: cobra.ExactArgs(2),
+	}
+	l7PolicyCreateCmd.Flags().StringVar(&cloud.CloudLoadbalancerL7PolicySpec.Name, "name", "", "Name of the L7 policy")
+	l7PolicyCreateCmd.Flags().StringVar(&cloud.CloudLoadbalancerL7PolicySpec.Action, "action", "", "Action of the policy ("+strings.Join(cloud.CloudLoadbalancerL7PolicyActions, ", ")+")")
+	l7PolicyCreateCmd.Flags().IntVar(&cloud.CloudLoadbalancerL7PolicySpec.Position, "position", 0, "Position of the policy in the listener policies")
+	l7PolicyCreateCmd.Flags().StringVar(&cloud.CloudLoadbalancerL7PolicySpec.RedirectPoolID, "redirect-pool", "", "ID of the pool to redirect to (action redirectToPool)")
+	l7PolicyCreateCmd.Flags().StringVar(&cloud.CloudLoadbalancerL7PolicySpec.RedirectURL, "redirect-url", "", "URL to redirect to (action redirectToURL)")
+	l7PolicyCreateCmd.Flags().StringVar(&cloud.CloudLoadbalancerL7PolicySpec.RedirectPrefix, "redirect-prefix", "", "Prefix to redirect to (action redirectPrefix)")
+	l7PolicyCreateCmd.Flags().IntVar(&cloud.CloudLoadbalancerL7PolicySpec.RedirectHTTPCode, "redirect-http-code", 0, "HTTP code of the redirection (301, 302, 303, 307 or 308)")
+	l7PolicyCreateCmd.MarkFlagRequired("action")
+	l7PolicyCmd.AddCommand(l7PolicyCreateCmd)
+
+	l7PolicyEditCmd := &cobra.Command{
+		Use:   "edit <loadbalancer_id> <l7policy_id>",
+		Short: "Edit the given L7 policy",
+		Run:   cloud.EditCloudLoadbalancerL7Policy,
+		Args:  cobra.ExactArgs(2),
+	}
+	l7PolicyEditCmd.Flags().StringVar(&cloud.CloudLoadbalancerL7PolicyUpdateSpec.Name, "name", "", "Name of the L7 policy")
+	l7PolicyEditCmd.Flags().StringVar(&cloud.CloudLoadbalancerL7PolicyUpdateSpec.Action, "action", "", "Action of the policy ("+strings.Join(cloud.CloudLoadbalancerL7PolicyActions, ", ")+")")
+	l7PolicyEditCmd.Flags().IntVar(&cloud.CloudLoadbalancerL7PolicyUpdateSpec.Position, "position", 0, "Position of the policy in the listener policies")
+	l7PolicyEditCmd.Flags().StringVar(&cloud.CloudLoadbalancerL7PolicyUpdateSpec.RedirectPoolID, "redirect-pool", "", "ID of the pool to redirect to (action redirectToPool)")
+	l7PolicyEditCmd.Flags().StringVar(&cloud.CloudLoadbalancerL7PolicyUpdateSpec.RedirectURL, "redirect-url", "", "URL to redirect to (action redirectToURL)")
+	l7PolicyEditCmd.Flags().StringVar(&cloud.CloudLoadbalancerL7PolicyUpdateSpec.RedirectPrefix, "redirect-prefix", "", "Prefix to redirect to (action redirectPrefix)")
+	l7PolicyEditCmd.Flags().IntVar(&cloud.CloudLoadbalancerL7PolicyUpdateSpec.RedirectHTTPCode, "redirect-http-code", 0, "HTTP code of the redirection (301, 302, 303, 307 or 308)")
+	addInteractiveEditorFlag(l7PolicyEditCmd)
+	l7PolicyCmd.AddCommand(l7PolicyEditCmd)
+
+	l7PolicyCmd.AddCommand(&cobra.Command{
+		Use:   "delete <loadbalancer_id> <l7policy_id>",
+		Short: "Delete the given L7 policy",
+		Run:   cloud.DeleteCloudLoadbalancerL7Policy,
+		Args:  cobra.ExactArgs(2),
+	})
+}
+
+// addLoadbalancerMemberWeightFlag adds the --weight flag to the given command. The
+// weight is only sent when the flag is given, as 0 is a valid weight.
+func addLoadbalancerMemberWeightFlag(cmd *cobra.Command, weight **int) {
+	var value int
+	cmd.Flags().IntVar(&value, "weight", 0, "Weight of the member (0-256)")
+
+	cmd.PreRunE = func(cmd *cobra.Command, _ []string) error {
+		if cmd.Flags().Changed("weight") {
+			*weight = &value
+		} else {
+			*weight = nil
+		}
+
+		return nil
+	}
+}
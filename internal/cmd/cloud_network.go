@@ -234,6 +234,66 @@ func initCloudNetworkCommand(cloudCmd *cobra.Command) {
 		Args:  cobra.ExactArgs(2),
 	})
 
+	// Floating IP commands
+	floatingIPCmd := &cobra.Command{
+		Use:   "floating-ip",
+		Short: "Manage floating IPs in the given cloud project",
+		Long: `Manage floating IPs in the given cloud project.
+
+Floating IPs can be referenced either by their ID or by their public IP address. For example, to move a floating IP
+to a standby instance during a failover:
+
+	ovhcloud cloud network floating-ip attach 51.75.x.x <standby_instance_id>`,
+	}
+	networkCmd.AddCommand(floatingIPCmd)
+
+	floatingIPListCmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "List your floating IPs in all regions",
+		Run:     cloud.ListFloatingIPs,
+	}
+	floatingIPCmd.AddCommand(withFilterFlag(floatingIPListCmd))
+
+	floatingIPCmd.AddCommand(&cobra.Command{
+		Use:   "get <floating_ip_id_or_address>",
+		Short: "Get a specific floating IP",
+		Run:   cloud.GetFloatingIP,
+		Args:  cobra.ExactArgs(1),
+	})
+
+	floatingIPCreateCmd := &cobra.Command{
+		Use:   "create <instance_id>",
+		Short: "Create a new floating IP and associate it with the given instance",
+		Run:   cloud.CreateFloatingIP,
+		Args:  cobra.ExactArgs(1),
+	}
+	floatingIPCreateCmd.Flags().StringVar(&cloud.FloatingIPInstanceIP, "ip", "", "Private IP of the instance to associate the floating IP with (defaults to its first private IPv4)")
+	floatingIPCmd.AddCommand(floatingIPCreateCmd)
+
+	floatingIPAttachCmd := &cobra.Command{
+		Use:   "attach <floating_ip_id_or_address> <instance_id>",
+		Short: "Associate the given floating IP with an instance, detaching it first if needed",
+		Run:   cloud.AttachFloatingIP,
+		Args:  cobra.ExactArgs(2),
+	}
+	floatingIPAttachCmd.Flags().StringVar(&cloud.FloatingIPInstanceIP, "ip", "", "Private IP of the instance to associate the floating IP with (defaults to its first private IPv4)")
+	floatingIPCmd.AddCommand(floatingIPAttachCmd)
+
+	floatingIPCmd.AddCommand(&cobra.Command{
+		Use:   "detach <floating_ip_id_or_address>",
+		Short: "Detach the given floating IP from its instance",
+		Run:   cloud.DetachFloatingIP,
+		Args:  cobra.ExactArgs(1),
+	})
+
+	floatingIPCmd.AddCommand(&cobra.Command{
+		Use:   "delete <floating_ip_id_or_address>",
+		Short: "Delete the given floating IP",
+		Run:   cloud.DeleteFloatingIP,
+		Args:  cobra.ExactArgs(1),
+	})
+
 	// Loadbalancer commands
 	loadbalancerCmd := &cobra.Command{
 		Use:   "loadbalancer",
@@ -267,6 +327,12 @@ func initCloudNetworkCommand(cloudCmd *cobra.Command) {
 	editLoadbalancerCmd.Flags().StringVar(&cloud.CloudLoadbalancerUpdateSpec.FlavorId, "flavor", "", "Flavor ID of the loadbalancer (can be retrieved with 'cloud reference loadbalancer list-flavors <region>')")
 	addInteractiveEditorFlag(editLoadbalancerCmd)
 	loadbalancerCmd.AddCommand(editLoadbalancerCmd)
+
+	initLoadbalancerListenerCommand(loadbalancerCmd)
+	initLoadbalancerPoolCommand(loadbalancerCmd)
+	initLoadbalancerMemberCommand(loadbalancerCmd)
+	initLoadbalancerHealthMonitorCommand(loadbalancerCmd)
+	initLoadbalancerL7PolicyCommand(loadbalancerCmd)
 }
 
 func getPrivateNetworkCreationCmd() *cobra.Command {
@@ -313,3 +313,358 @@ func (ms *MockSuite) TestCloudLoadbalancerGetCmd(assert, require *td.T) {
 
 `)
 }
+
+func (ms *MockSuite) TestCloudFloatingIPListCmd(assert, require *td.T) {
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region",
+		httpmock.NewStringResponder(200, `["GRA11", "SBG5"]`))
+
+	for _, region := range []string{"GRA11", "SBG5"} {
+		httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/"+region,
+			httpmock.NewStringResponder(200, `{
+				"name": "`+region+`",
+				"type": "region",
+				"status": "UP",
+				"services": [
+					{
+						"name": "network",
+						"status": "UP"
+					}
+				]
+			}`))
+	}
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/GRA11/floatingip",
+		httpmock.NewStringResponder(200, `[
+			{
+				"id": "fakeFloatingIPID",
+				"ip": "51.75.0.10",
+				"networkId": "fakeExtNetworkID",
+				"region": "GRA11",
+				"status": "ACTIVE",
+				"associatedEntity": {
+					"id": "primaryInstanceID",
+					"ip": "10.0.0.11",
+					"type": "instance",
+					"gatewayId": "fakeGatewayID"
+				}
+			}
+		]`))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/SBG5/floatingip",
+		httpmock.NewStringResponder(200, `[]`))
+
+	out, err := cmd.Execute("cloud", "network", "floating-ip", "list", "--cloud-project", "fakeProjectID", "--json")
+	require.CmpNoError(err)
+	assert.Cmp(json.RawMessage(out), td.JSON(`[
+		{
+			"id": "fakeFloatingIPID",
+			"ip": "51.75.0.10",
+			"region": "GRA11",
+			"status": "ACTIVE",
+			"networkId": "fakeExtNetworkID",
+			"associatedEntity": {
+				"id": "primaryInstanceID",
+				"ip": "10.0.0.11",
+				"type": "instance",
+				"gatewayId": "fakeGatewayID"
+			}
+		}
+	]`))
+}
+
+func (ms *MockSuite) TestCloudFloatingIPAttachCmd(assert, require *td.T) {
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region",
+		httpmock.NewStringResponder(200, `["GRA11", "SBG5"]`))
+
+	for _, region := range []string{"GRA11", "SBG5"} {
+		httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/"+region,
+			httpmock.NewStringResponder(200, `{
+				"name": "`+region+`",
+				"type": "region",
+				"status": "UP",
+				"services": [
+					{
+						"name": "network",
+						"status": "UP"
+					}
+				]
+			}`))
+	}
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/GRA11/floatingip",
+		httpmock.NewStringResponder(200, `[
+			{
+				"id": "fakeFloatingIPID",
+				"ip": "51.75.0.10",
+				"networkId": "fakeExtNetworkID",
+				"region": "GRA11",
+				"status": "ACTIVE",
+				"associatedEntity": {
+					"id": "primaryInstanceID",
+					"ip": "10.0.0.11",
+					"type": "instance",
+					"gatewayId": "fakeGatewayID"
+				}
+			}
+		]`))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/SBG5/floatingip",
+		httpmock.NewStringResponder(200, `[]`))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/instance/standbyInstanceID",
+		httpmock.NewStringResponder(200, `{
+			"id": "standbyInstanceID",
+			"region": "GRA11",
+			"ipAddresses": [
+				{"ip": "2001:db8::12", "type": "private", "version": 6},
+				{"ip": "10.0.0.12", "type": "private", "version": 4}
+			]
+		}`))
+
+	httpmock.RegisterResponder(http.MethodPost, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/GRA11/floatingip/fakeFloatingIPID/detach",
+		httpmock.NewStringResponder(200, `null`))
+
+	httpmock.RegisterMatcherResponder(http.MethodPost, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/GRA11/instance/standbyInstanceID/associateFloatingIp",
+		tdhttpmock.JSONBody(td.JSON(`
+			{
+				"floatingIpId": "fakeFloatingIPID",
+				"ip": "10.0.0.12"
+			}`),
+		),
+		httpmock.NewStringResponder(200, `null`))
+
+	out, err := cmd.Execute("cloud", "network", "floating-ip", "attach", "51.75.0.10", "standbyInstanceID", "--cloud-project", "fakeProjectID", "--json")
+	require.CmpNoError(err)
+	assert.Cmp(json.RawMessage(out), td.JSON(`{"message": "✅ Floating IP 51.75.0.10 associated with instance standbyInstanceID"}`))
+	assert.Cmp(httpmock.GetCallCountInfo()["POST https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/GRA11/floatingip/fakeFloatingIPID/detach"], 1)
+}
+
+func (ms *MockSuite) TestCloudLoadbalancerListenerListCmd(assert, require *td.T) {
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region",
+		httpmock.NewStringResponder(200, `["GRA11", "SBG5"]`))
+
+	for _, region := range []string{"GRA11", "SBG5"} {
+		httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/"+region,
+			httpmock.NewStringResponder(200, `{
+				"name": "`+region+`",
+				"type": "region",
+				"status": "UP",
+				"services": [
+					{
+						"name": "octavialoadbalancer",
+						"status": "UP"
+					}
+				]
+			}`))
+	}
+
+	httpmock.RegisterResponder(http.MethodGet,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/GRA11/loadbalancing/loadbalancer/fakeLB",
+		httpmock.NewStringResponder(404, `{"message": "not found"}`))
+
+	httpmock.RegisterResponder(http.MethodGet,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/SBG5/loadbalancing/loadbalancer/fakeLB",
+		httpmock.NewStringResponder(200, `{
+			"id": "fakeLB",
+			"name": "lb-sbg5",
+			"region": "SBG5"
+		}`))
+
+	httpmock.RegisterResponder(http.MethodGet,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/SBG5/loadbalancing/listener?loadbalancerId=fakeLB",
+		httpmock.NewStringResponder(200, `[
+			{
+				"id": "fakeListenerID",
+				"name": "http",
+				"protocol": "http",
+				"port": 80,
+				"defaultPoolId": "fakePoolID",
+				"provisioningStatus": "active",
+				"operatingStatus": "online"
+			}
+		]`))
+
+	out, err := cmd.Execute("cloud", "network", "loadbalancer", "listener", "list", "fakeLB", "--cloud-project", "fakeProjectID", "--json")
+	require.CmpNoError(err)
+	assert.Cmp(json.RawMessage(out), td.JSON(`[
+		{
+			"id": "fakeListenerID",
+			"name": "http",
+			"protocol": "http",
+			"port": 80,
+			"defaultPoolId": "fakePoolID",
+			"provisioningStatus": "active",
+			"operatingStatus": "online"
+		}
+	]`))
+}
+
+func (ms *MockSuite) TestCloudLoadbalancerPoolCreateCmd(assert, require *td.T) {
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region",
+		httpmock.NewStringResponder(200, `["GRA11", "SBG5"]`))
+
+	for _, region := range []string{"GRA11", "SBG5"} {
+		httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/"+region,
+			httpmock.NewStringResponder(200, `{
+				"name": "`+region+`",
+				"type": "region",
+				"status": "UP",
+				"services": [
+					{
+						"name": "octavialoadbalancer",
+						"status": "UP"
+					}
+				]
+			}`))
+	}
+
+	httpmock.RegisterResponder(http.MethodGet,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/GRA11/loadbalancing/loadbalancer/fakeLB",
+		httpmock.NewStringResponder(404, `{"message": "not found"}`))
+
+	httpmock.RegisterResponder(http.MethodGet,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/SBG5/loadbalancing/loadbalancer/fakeLB",
+		httpmock.NewStringResponder(200, `{
+			"id": "fakeLB",
+			"name": "lb-sbg5",
+			"region": "SBG5"
+		}`))
+
+	httpmock.RegisterMatcherResponder(http.MethodPost,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/SBG5/loadbalancing/pool",
+		tdhttpmock.JSONBody(td.JSON(`{
+			"name": "web",
+			"protocol": "http",
+			"algorithm": "roundRobin",
+			"listenerId": "fakeListenerID",
+			"loadbalancerId": "fakeLB"
+		}`)),
+		httpmock.NewStringResponder(200, `{"id": "fakePoolID"}`),
+	)
+
+	out, err := cmd.Execute("cloud", "network", "loadbalancer", "pool", "create", "fakeLB", "--name", "web", "--protocol", "http",
+		"--listener", "fakeListenerID", "--cloud-project", "fakeProjectID", "--json")
+	require.CmpNoError(err)
+	assert.Cmp(json.RawMessage(out), td.JSON(`{
+		"message": "✅ Pool fakePoolID created successfully",
+		"details": {"id": "fakePoolID"}
+	}`))
+}
+
+func (ms *MockSuite) TestCloudLoadbalancerMemberAddInstanceCmd(assert, require *td.T) {
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region",
+		httpmock.NewStringResponder(200, `["GRA11", "SBG5"]`))
+
+	for _, region := range []string{"GRA11", "SBG5"} {
+		httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/"+region,
+			httpmock.NewStringResponder(200, `{
+				"name": "`+region+`",
+				"type": "region",
+				"status": "UP",
+				"services": [
+					{
+						"name": "octavialoadbalancer",
+						"status": "UP"
+					}
+				]
+			}`))
+	}
+
+	httpmock.RegisterResponder(http.MethodGet,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/GRA11/loadbalancing/loadbalancer/fakeLB",
+		httpmock.NewStringResponder(404, `{"message": "not found"}`))
+
+	httpmock.RegisterResponder(http.MethodGet,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/SBG5/loadbalancing/loadbalancer/fakeLB",
+		httpmock.NewStringResponder(200, `{
+			"id": "fakeLB",
+			"name": "lb-sbg5",
+			"region": "SBG5"
+		}`))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/instance/fakeInstanceID",
+		httpmock.NewStringResponder(200, `{
+			"id": "fakeInstanceID",
+			"name": "web-1",
+			"region": "SBG5",
+			"ipAddresses": [
+				{"ip": "51.75.0.20", "type": "public", "version": 4},
+				{"ip": "10.0.0.12", "type": "private", "version": 4}
+			]
+		}`))
+
+	httpmock.RegisterMatcherResponder(http.MethodPost,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/SBG5/loadbalancing/pool/fakePoolID/member",
+		tdhttpmock.JSONBody(td.JSON(`{
+			"members": [
+				{
+					"name": "fakeInstanceID",
+					"address": "10.0.0.12",
+					"protocolPort": 8080,
+					"weight": 0
+				}
+			]
+		}`)),
+		httpmock.NewStringResponder(200, `[{"id": "fakeMemberID"}]`),
+	)
+
+	out, err := cmd.Execute("cloud", "network", "loadbalancer", "member", "add-instance", "fakeLB", "fakePoolID", "fakeInstanceID",
+		"--port", "8080", "--weight", "0", "--cloud-project", "fakeProjectID", "--json")
+	require.CmpNoError(err)
+	assert.Cmp(json.RawMessage(out), td.JSON(`{
+		"message": "✅ Instance fakeInstanceID (10.0.0.12:8080) added to pool fakePoolID",
+		"details": {
+			"id": "fakeMemberID",
+			"address": "10.0.0.12"
+		}
+	}`))
+}
+
+func (ms *MockSuite) TestCloudLoadbalancerHealthMonitorListCmd(assert, require *td.T) {
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region",
+		httpmock.NewStringResponder(200, `["GRA11", "SBG5"]`))
+
+	for _, region := range []string{"GRA11", "SBG5"} {
+		httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/"+region,
+			httpmock.NewStringResponder(200, `{
+				"name": "`+region+`",
+				"type": "region",
+				"status": "UP",
+				"services": [
+					{
+						"name": "octavialoadbalancer",
+						"status": "UP"
+					}
+				]
+			}`))
+	}
+
+	httpmock.RegisterResponder(http.MethodGet,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/GRA11/loadbalancing/loadbalancer/fakeLB",
+		httpmock.NewStringResponder(404, `{"message": "not found"}`))
+
+	httpmock.RegisterResponder(http.MethodGet,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/SBG5/loadbalancing/loadbalancer/fakeLB",
+		httpmock.NewStringResponder(200, `{
+			"id": "fakeLB",
+			"name": "lb-sbg5",
+			"region": "SBG5"
+		}`))
+
+	httpmock.RegisterResponder(http.MethodGet,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/SBG5/loadbalancing/pool?loadbalancerId=fakeLB",
+		httpmock.NewStringResponder(200, `[{"id": "fakePoolID"}]`))
+
+	httpmock.RegisterResponder(http.MethodGet,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/SBG5/loadbalancing/healthMonitor",
+		httpmock.NewStringResponder(200, `[
+			{"id": "fakeMonitorID", "name": "http-check", "poolId": "fakePoolID", "monitorType": "http"},
+			{"id": "otherMonitorID", "name": "other", "poolId": "otherPoolID", "monitorType": "tcp"}
+		]`))
+
+	out, err := cmd.Execute("cloud", "network", "loadbalancer", "health-monitor", "list", "fakeLB", "--cloud-project", "fakeProjectID", "--json")
+	require.CmpNoError(err)
+	assert.Cmp(json.RawMessage(out), td.JSON(`[
+		{"id": "fakeMonitorID", "name": "http-check", "poolId": "fakePoolID", "monitorType": "http"}
+	]`))
+}
@@ -5,6 +5,8 @@
 package cmd
 
 import (
+	"strings"
+
 	"github.com/ovh/ovhcloud-cli/internal/services/cloud"
 	"github.com/spf13/cobra"
 )
@@ -21,12 +23,12 @@ func init() {
 	}
 
 	// Command to list CloudProject services
-	cloudprojectCmd.AddCommand(withFilterFlag(&cobra.Command{
+	cloudprojectCmd.AddCommand(withIAMTagsFlag(withFilterFlag(&cobra.Command{
 		Use:     "list",
 		Aliases: []string{"ls"},
 		Short:   "List your cloud projects",
 		Run:     cloud.ListCloudProject,
-	}))
+	})))
 
 	// Command to get a single CloudProject
 	cloudprojectCmd.AddCommand(&cobra.Command{
@@ -47,14 +49,18 @@ func init() {
 	addInteractiveEditorFlag(editCloudProjectCmd)
 	cloudprojectCmd.AddCommand(editCloudProjectCmd)
 
+	initCloudProjectUsageCommand(cloudprojectCmd)
+
 	initKubeCommand(cloudCmd)
 	initContainerRegistryCommand(cloudCmd)
 	initCloudDatabaseCommand(cloudCmd)
 	initInstanceCommand(cloudCmd)
+	initInstanceGroupCommand(cloudCmd)
 	initCloudNetworkCommand(cloudCmd)
 	initCloudOperationCommand(cloudCmd)
 	initCloudQuotaCommand(cloudCmd)
 	initCloudRegionCommand(cloudCmd)
+	initCloudSecurityGroupCommand(cloudCmd)
 	initCloudSSHKeyCommand(cloudCmd)
 	initCloudUserCommand(cloudCmd)
 	initCloudStorageS3Command(cloudCmd)
@@ -66,3 +72,52 @@ func init() {
 	cloudCmd.AddCommand(cloudprojectCmd)
 	rootCmd.AddCommand(cloudCmd)
 }
+
+func initCloudProjectUsageCommand(cloudprojectCmd *cobra.Command) {
+	usageCmd := &cobra.Command{
+		Use:   "usage",
+		Short: "Display the resources consumption and the cost of the given cloud project",
+		Long: `Display the resources consumption and the cost of the given cloud project.
+
+Consumption is reported with one line per resource (instance, volume, snapshot…),
+billed hourly or monthly, followed by a line with the total price. Lines can be
+grouped by resource type, region or instance using --group-by.
+
+Examples:
+	ovhcloud cloud project usage current --group-by region
+	ovhcloud cloud project usage history --since 90d --group-by instance --csv > usage.csv
+	ovhcloud cloud project usage current --filter 'price > 10' --json`,
+	}
+	usageCmd.PersistentFlags().StringVar(&cloud.CloudProject, "cloud-project", "", "Cloud project ID")
+	usageCmd.PersistentFlags().StringVar(&cloud.CloudProjectUsageParams.GroupBy, "group-by", "", "Group lines by the given key ("+strings.Join(cloud.CloudProjectUsageGroupings, ", ")+")")
+	usageCmd.PersistentFlags().BoolVar(&cloud.CloudProjectUsageParams.CSV, "csv", false, "Output in CSV")
+	cloudprojectCmd.AddCommand(usageCmd)
+
+	usageCmd.AddCommand(withFilterFlag(&cobra.Command{
+		Use:   "current",
+		Short: "Display the consumption of the current billing period",
+		Run:   cloud.GetCloudProjectCurrentUsage,
+		Args:  cobra.NoArgs,
+	}))
+
+	usageHistoryCmd := &cobra.Command{
+		Use:   "history",
+		Short: "Display the consumption of the past billing periods",
+		Long: `Display the consumption of the past billing periods.
+
+The --since and --until flags accept a duration relative to now (30d, 12w),
+a date (2025-01-31) or a RFC3339 timestamp.`,
+		Run:  cloud.GetCloudProjectUsageHistory,
+		Args: cobra.NoArgs,
+	}
+	usageHistoryCmd.Flags().StringVar(&cloud.CloudProjectUsageParams.Since, "since", "", "Only display billing periods after the given date")
+	usageHistoryCmd.Flags().StringVar(&cloud.CloudProjectUsageParams.Until, "until", "", "Only display billing periods before the given date")
+	usageCmd.AddCommand(withFilterFlag(usageHistoryCmd))
+
+	usageCmd.AddCommand(withFilterFlag(&cobra.Command{
+		Use:   "forecast",
+		Short: "Display the forecasted consumption at the end of the current billing period",
+		Run:   cloud.GetCloudProjectForecastUsage,
+		Args:  cobra.NoArgs,
+	}))
+}
@@ -0,0 +1,151 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd_test
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/maxatome/go-testdeep/td"
+	"github.com/ovh/ovhcloud-cli/internal/cmd"
+)
+
+const cloudProjectUsageResponse = `{
+	"period": {
+		"from": "2025-10-01T00:00:00Z",
+		"to": "2025-10-19T12:00:00Z"
+	},
+	"lastUpdate": "2025-10-19T12:00:00Z",
+	"hourlyUsage": {
+		"instance": [
+			{
+				"region": "GRA11",
+				"reference": "b2-7",
+				"quantity": {"unit": "Hour", "value": 200},
+				"totalPrice": 14.5,
+				"details": [
+					{"instanceId": "instance-1", "quantity": {"unit": "Hour", "value": 150}, "totalPrice": 10.875},
+					{"instanceId": "instance-2", "quantity": {"unit": "Hour", "value": 50}, "totalPrice": 3.625}
+				]
+			}
+		],
+		"volume": [
+			{
+				"region": "SBG5",
+				"reference": "classic",
+				"quantity": {"unit": "GiBh", "value": 1000},
+				"totalPrice": 0.05,
+				"details": [
+					{"volumeId": "volume-1", "quantity": {"unit": "GiBh", "value": 1000}, "totalPrice": 0.05}
+				]
+			}
+		]
+	},
+	"monthlyUsage": {
+		"instance": [
+			{
+				"region": "SBG5",
+				"reference": "b2-15",
+				"quantity": {"unit": "Month", "value": 1},
+				"totalPrice": 40,
+				"details": [
+					{"instanceId": "instance-3", "quantity": {"unit": "Month", "value": 1}, "totalPrice": 40}
+				]
+			}
+		]
+	}
+}`
+
+func (ms *MockSuite) TestCloudProjectUsageCurrentCmd(assert, require *td.T) {
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/usage/current",
+		httpmock.NewStringResponder(200, cloudProjectUsageResponse))
+
+	out, err := cmd.Execute("cloud", "project", "usage", "current", "--cloud-project", "fakeProjectID", "--json")
+	require.CmpNoError(err)
+	assert.Cmp(json.RawMessage(out), td.JSON(`[
+		{
+			"period": "2025-10-01T00:00:00Z",
+			"billing": "hourly",
+			"resource": "instance",
+			"region": "GRA11",
+			"reference": "b2-7",
+			"resourceId": "instance-1",
+			"quantity": 150,
+			"unit": "Hour",
+			"price": 10.875
+		},
+		{
+			"period": "2025-10-01T00:00:00Z",
+			"billing": "hourly",
+			"resource": "instance",
+			"region": "GRA11",
+			"reference": "b2-7",
+			"resourceId": "instance-2",
+			"quantity": 50,
+			"unit": "Hour",
+			"price": 3.625
+		},
+		{
+			"period": "2025-10-01T00:00:00Z",
+			"billing": "hourly",
+			"resource": "volume",
+			"region": "SBG5",
+			"reference": "classic",
+			"resourceId": "volume-1",
+			"quantity": 1000,
+			"unit": "GiBh",
+			"price": 0.05
+		},
+		{
+			"period": "2025-10-01T00:00:00Z",
+			"billing": "monthly",
+			"resource": "instance",
+			"region": "SBG5",
+			"reference": "b2-15",
+			"resourceId": "instance-3",
+			"quantity": 1,
+			"unit": "Month",
+			"price": 40
+		},
+		{
+			"resource": "total",
+			"price": 54.55
+		}
+	]`))
+}
+
+func (ms *MockSuite) TestCloudProjectUsageCurrentGroupByCSVCmd(assert, require *td.T) {
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/usage/current",
+		httpmock.NewStringResponder(200, cloudProjectUsageResponse))
+
+	out, err := cmd.Execute("cloud", "project", "usage", "current", "--group-by", "region", "--csv", "--cloud-project", "fakeProjectID")
+	require.CmpNoError(err)
+	assert.String(out, "region,lines,price\nSBG5,2,40.05\nGRA11,2,14.5\ntotal,,54.55")
+}
+
+func (ms *MockSuite) TestCloudProjectUsageHistoryCmd(assert, require *td.T) {
+	httpmock.RegisterResponder(http.MethodGet,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/usage/history?from=2025-09-01T00%3A00%3A00Z&to=2025-10-01T00%3A00%3A00Z",
+		httpmock.NewStringResponder(200, `[
+			{
+				"id": "usage-september",
+				"period": {"from": "2025-09-01T00:00:00Z", "to": "2025-09-30T23:59:59Z"}
+			}
+		]`))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/usage/history/usage-september",
+		httpmock.NewStringResponder(200, cloudProjectUsageResponse))
+
+	out, err := cmd.Execute("cloud", "project", "usage", "history", "--since", "2025-09-01", "--until", "2025-10-01",
+		"--group-by", "instance", "--cloud-project", "fakeProjectID", "--json")
+	require.CmpNoError(err)
+	assert.Cmp(json.RawMessage(out), td.JSON(`[
+		{"instance": "instance-3", "lines": 1, "price": 40},
+		{"instance": "instance-1", "lines": 1, "price": 10.88},
+		{"instance": "instance-2", "lines": 1, "price": 3.63},
+		{"instance": "total", "price": 54.5}
+	]`))
+}
@@ -0,0 +1,141 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"github.com/ovh/ovhcloud-cli/internal/services/cloud"
+	"github.com/spf13/cobra"
+)
+
+func initCloudSecurityGroupCommand(cloudCmd *cobra.Command) {
+	securityGroupCmd := &cobra.Command{
+		Use:   "security-group",
+		Short: "Manage security groups in the given cloud project",
+	}
+	securityGroupCmd.PersistentFlags().StringVar(&cloud.CloudProject, "cloud-project", "", "Cloud project ID")
+
+	securityGroupListCmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "List your security groups",
+		Run:     cloud.ListSecurityGroups,
+	}
+	securityGroupCmd.AddCommand(withFilterFlag(securityGroupListCmd))
+
+	securityGroupCmd.AddCommand(&cobra.Command{
+		Use:   "get <group_id>",
+		Short: "Get a specific security group",
+		Run:   cloud.GetSecurityGroup,
+		Args:  cobra.ExactArgs(1),
+	})
+
+	securityGroupCreateCmd := &cobra.Command{
+		Use:   "create <region> <name>",
+		Short: "Create a new security group",
+		Run:   cloud.CreateSecurityGroup,
+		Args:  cobra.ExactArgs(2),
+	}
+	securityGroupCreateCmd.Flags().StringVar(&cloud.SecurityGroupDescription, "description", "", "Description of the security group")
+	securityGroupCmd.AddCommand(securityGroupCreateCmd)
+
+	securityGroupCmd.AddCommand(&cobra.Command{
+		Use:   "delete <group_id>",
+		Short: "Delete the given security group",
+		Run:   cloud.DeleteSecurityGroup,
+		Args:  cobra.ExactArgs(1),
+	})
+
+	securityGroupExportCmd := &cobra.Command{
+		Use:   "export <group_id>",
+		Short: "Export the given security group and its rules in YAML format",
+		Long: `Export the given security group and its rules in YAML format.
+
+The exported file can be kept under version control and applied
+to any region using the import command.
+
+Example:
+	ovhcloud cloud security-group export <group_id> --file web.yaml`,
+		Run:  cloud.ExportSecurityGroup,
+		Args: cobra.ExactArgs(1),
+	}
+	securityGroupExportCmd.Flags().StringVar(&cloud.SecurityGroupExportFile, "file", "", "File to write the security group to (defaults to standard output)")
+	securityGroupCmd.AddCommand(securityGroupExportCmd)
+
+	securityGroupImportCmd := &cobra.Command{
+		Use:   "import <region> <file>",
+		Short: "Create or update a security group from a YAML file",
+		Long: `Create or update a security group from a YAML file.
+
+The security group is looked up by name in the given region and created if
+it does not exist. Its rules are then reconciled with the ones of the file:
+missing rules are added and rules not present in the file are removed. This
+includes the default egress rules of newly created groups, which a dry run
+cannot take into account.
+
+File format:
+	name: web
+	description: Web servers
+	rules:
+	  - direction: ingress
+	    protocol: tcp
+	    port: "443"
+	    cidr: 0.0.0.0/0
+	  - direction: ingress
+	    protocol: tcp
+	    port: 8000-8100
+	    remoteGroup: <group_id>
+	  - direction: ingress
+	    protocol: icmp
+	    icmpType: 8
+	    cidr: 0.0.0.0/0
+
+Protocols other than tcp, udp and icmp (e.g. gre or vrrp) are passed as is to the API.
+
+Example:
+	ovhcloud cloud security-group import GRA11 web.yaml --dry-run`,
+		Run:  cloud.ImportSecurityGroup,
+		Args: cobra.ExactArgs(2),
+	}
+	securityGroupImportCmd.Flags().BoolVar(&cloud.SecurityGroupImportParams.DryRun, "dry-run", false, "Only display the changes that would be applied")
+	securityGroupCmd.AddCommand(securityGroupImportCmd)
+
+	// Rule commands
+	ruleCmd := &cobra.Command{
+		Use:   "rule",
+		Short: "Manage rules of security groups",
+	}
+	securityGroupCmd.AddCommand(ruleCmd)
+
+	ruleAddCmd := &cobra.Command{
+		Use:   "add <group_id>",
+		Short: "Add a rule to the given security group",
+		Long: `Add a rule to the given security group.
+
+Example:
+	ovhcloud cloud security-group rule add <group_id> --protocol tcp --port 22 --cidr 203.0.113.0/24
+	ovhcloud cloud security-group rule add <group_id> --protocol tcp --port 5432 --remote-group <other_group_id>`,
+		Run:  cloud.AddSecurityGroupRule,
+		Args: cobra.ExactArgs(1),
+	}
+	ruleAddCmd.Flags().StringVar(&cloud.SecurityGroupRuleSpec.Direction, "direction", "ingress", "Direction of the traffic (ingress, egress)")
+	ruleAddCmd.Flags().StringVar(&cloud.SecurityGroupRuleSpec.Protocol, "protocol", "any", "Protocol (tcp, udp, icmp, any or any other protocol name or number)")
+	ruleAddCmd.Flags().StringVar(&cloud.SecurityGroupRuleSpec.Port, "port", "", "Port or port range (e.g. 22 or 8000-8100), only for tcp, udp, udplite, sctp and dccp")
+	ruleAddCmd.Flags().StringVar(&cloud.SecurityGroupRuleSpec.CIDR, "cidr", "", "Remote CIDR to allow (e.g. 0.0.0.0/0)")
+	ruleAddCmd.Flags().StringVar(&cloud.SecurityGroupRuleSpec.RemoteGroup, "remote-group", "", "Remote security group ID to allow")
+	ruleAddCmd.Flags().StringVar(&cloud.SecurityGroupRuleSpec.Ethertype, "ethertype", "", "Ethertype (IPv4, IPv6), deduced from the CIDR if not given")
+	ruleAddCmd.Flags().StringVar(&cloud.SecurityGroupRuleSpec.Description, "description", "", "Description of the rule")
+	ruleAddCmd.MarkFlagsMutuallyExclusive("cidr", "remote-group")
+	ruleCmd.AddCommand(ruleAddCmd)
+
+	ruleCmd.AddCommand(&cobra.Command{
+		Use:     "remove <group_id> <rule_id>",
+		Aliases: []string{"rm"},
+		Short:   "Remove a rule from the given security group",
+		Run:     cloud.RemoveSecurityGroupRule,
+		Args:    cobra.ExactArgs(2),
+	})
+
+	cloudCmd.AddCommand(securityGroupCmd)
+}
@@ -0,0 +1,465 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/maxatome/go-testdeep/td"
+	"github.com/maxatome/tdhttpmock"
+	"github.com/ovh/ovhcloud-cli/internal/cmd"
+)
+
+func (ms *MockSuite) TestCloudSecurityGroupListCmd(assert, require *td.T) {
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region",
+		httpmock.NewStringResponder(200, `["GRA11", "SBG5"]`))
+
+	for _, region := range []string{"GRA11", "SBG5"} {
+		httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/"+region,
+			httpmock.NewStringResponder(200, `{
+				"name": "`+region+`",
+				"type": "region",
+				"status": "UP",
+				"services": [
+					{
+						"name": "network",
+						"status": "UP"
+					}
+				]
+			}`))
+	}
+
+	group := `{
+		"id": "fakeGroupID",
+		"name": "web",
+		"description": "Web servers",
+		"rules": [
+			{
+				"id": "ruleHTTPS",
+				"direction": "ingress",
+				"ethertype": "IPv4",
+				"protocol": "tcp",
+				"portRangeMin": 443,
+				"portRangeMax": 443,
+				"remoteIpPrefix": "0.0.0.0/0"
+			},
+			{
+				"id": "ruleSSH",
+				"direction": "ingress",
+				"ethertype": "IPv4",
+				"protocol": "tcp",
+				"portRangeMin": 22,
+				"portRangeMax": 22,
+				"remoteIpPrefix": "0.0.0.0/0"
+			}
+		]
+	}`
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/GRA11/securityGroup",
+		httpmock.NewStringResponder(200, `[`+group+`]`))
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/SBG5/securityGroup",
+		httpmock.NewStringResponder(200, `[]`))
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/GRA11/securityGroup/fakeGroupID",
+		httpmock.NewStringResponder(200, group))
+
+	out, err := cmd.Execute("cloud", "security-group", "list", "--cloud-project", "fakeProjectID", "--json")
+	require.CmpNoError(err)
+	assert.Cmp(json.RawMessage(out), td.JSON(`[
+		SuperMapOf({
+			"id": "fakeGroupID",
+			"name": "web",
+			"region": "GRA11",
+			"description": "Web servers"
+		})
+	]`))
+}
+
+func (ms *MockSuite) TestCloudSecurityGroupRuleAddCmd(assert, require *td.T) {
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region",
+		httpmock.NewStringResponder(200, `["GRA11", "SBG5"]`))
+
+	for _, region := range []string{"GRA11", "SBG5"} {
+		httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/"+region,
+			httpmock.NewStringResponder(200, `{
+				"name": "`+region+`",
+				"type": "region",
+				"status": "UP",
+				"services": [
+					{
+						"name": "network",
+						"status": "UP"
+					}
+				]
+			}`))
+	}
+
+	group := `{
+		"id": "fakeGroupID",
+		"name": "web",
+		"description": "Web servers",
+		"rules": [
+			{
+				"id": "ruleHTTPS",
+				"direction": "ingress",
+				"ethertype": "IPv4",
+				"protocol": "tcp",
+				"portRangeMin": 443,
+				"portRangeMax": 443,
+				"remoteIpPrefix": "0.0.0.0/0"
+			},
+			{
+				"id": "ruleSSH",
+				"direction": "ingress",
+				"ethertype": "IPv4",
+				"protocol": "tcp",
+				"portRangeMin": 22,
+				"portRangeMax": 22,
+				"remoteIpPrefix": "0.0.0.0/0"
+			}
+		]
+	}`
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/GRA11/securityGroup",
+		httpmock.NewStringResponder(200, `[`+group+`]`))
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/SBG5/securityGroup",
+		httpmock.NewStringResponder(200, `[]`))
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/GRA11/securityGroup/fakeGroupID",
+		httpmock.NewStringResponder(200, group))
+
+	httpmock.RegisterMatcherResponder(http.MethodPost,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/GRA11/securityGroup/fakeGroupID/rule",
+		tdhttpmock.JSONBody(td.JSON(`{
+			"direction": "ingress",
+			"ethertype": "IPv6",
+			"protocol": "tcp",
+			"portRangeMin": 8000,
+			"portRangeMax": 8100,
+			"remoteIpPrefix": "2001:db8::/32"
+		}`)),
+		httpmock.NewStringResponder(200, `{"id": "newRuleID"}`),
+	)
+
+	out, err := cmd.Execute("cloud", "security-group", "rule", "add", "fakeGroupID", "--protocol", "tcp", "--port", "8000-8100",
+		"--cidr", "2001:db8::1/32", "--cloud-project", "fakeProjectID", "--json")
+	require.CmpNoError(err)
+	assert.Cmp(json.RawMessage(out), td.JSON(`{
+		"message": "✅ Rule newRuleID added to security group fakeGroupID",
+		"details": {"id": "newRuleID"}
+	}`))
+}
+
+func (ms *MockSuite) TestCloudSecurityGroupExportCmd(assert, require *td.T) {
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region",
+		httpmock.NewStringResponder(200, `["GRA11", "SBG5"]`))
+
+	for _, region := range []string{"GRA11", "SBG5"} {
+		httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/"+region,
+			httpmock.NewStringResponder(200, `{
+				"name": "`+region+`",
+				"type": "region",
+				"status": "UP",
+				"services": [
+					{
+						"name": "network",
+						"status": "UP"
+					}
+				]
+			}`))
+	}
+
+	group := `{
+		"id": "fakeGroupID",
+		"name": "web",
+		"description": "Web servers",
+		"rules": [
+			{
+				"id": "ruleHTTPS",
+				"direction": "ingress",
+				"ethertype": "IPv4",
+				"protocol": "tcp",
+				"portRangeMin": 443,
+				"portRangeMax": 443,
+				"remoteIpPrefix": "0.0.0.0/0"
+			},
+			{
+				"id": "ruleSSH",
+				"direction": "ingress",
+				"ethertype": "IPv4",
+				"protocol": "tcp",
+				"portRangeMin": 22,
+				"portRangeMax": 22,
+				"remoteIpPrefix": "0.0.0.0/0"
+			}
+		]
+	}`
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/GRA11/securityGroup",
+		httpmock.NewStringResponder(200, `[`+group+`]`))
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/SBG5/securityGroup",
+		httpmock.NewStringResponder(200, `[]`))
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/GRA11/securityGroup/fakeGroupID",
+		httpmock.NewStringResponder(200, group))
+
+	out, err := cmd.Execute("cloud", "security-group", "export", "fakeGroupID", "--cloud-project", "fakeProjectID")
+	require.CmpNoError(err)
+	assert.String(out, `description: Web servers
+name: web
+rules:
+- cidr: 0.0.0.0/0
+  direction: ingress
+  ethertype: IPv4
+  port: "443"
+  protocol: tcp
+- cidr: 0.0.0.0/0
+  direction: ingress
+  ethertype: IPv4
+  port: "22"
+  protocol: tcp`)
+}
+
+func (ms *MockSuite) TestCloudSecurityGroupImportCmd(assert, require *td.T) {
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region",
+		httpmock.NewStringResponder(200, `["GRA11", "SBG5"]`))
+
+	for _, region := range []string{"GRA11", "SBG5"} {
+		httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/"+region,
+			httpmock.NewStringResponder(200, `{
+				"name": "`+region+`",
+				"type": "region",
+				"status": "UP",
+				"services": [
+					{
+						"name": "network",
+						"status": "UP"
+					}
+				]
+			}`))
+	}
+
+	group := `{
+		"id": "fakeGroupID",
+		"name": "web",
+		"description": "Web servers",
+		"rules": [
+			{
+				"id": "ruleHTTPS",
+				"direction": "ingress",
+				"ethertype": "IPv4",
+				"protocol": "tcp",
+				"portRangeMin": 443,
+				"portRangeMax": 443,
+				"remoteIpPrefix": "0.0.0.0/0"
+			},
+			{
+				"id": "ruleSSH",
+				"direction": "ingress",
+				"ethertype": "IPv4",
+				"protocol": "tcp",
+				"portRangeMin": 22,
+				"portRangeMax": 22,
+				"remoteIpPrefix": "0.0.0.0/0"
+			}
+		]
+	}`
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/GRA11/securityGroup",
+		httpmock.NewStringResponder(200, `[`+group+`]`))
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/SBG5/securityGroup",
+		httpmock.NewStringResponder(200, `[]`))
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/GRA11/securityGroup/fakeGroupID",
+		httpmock.NewStringResponder(200, group))
+
+	file := filepath.Join(assert.TempDir(), "web.yaml")
+	require.CmpNoError(os.WriteFile(file, []byte(`name: web
+rules:
+  - direction: ingress
+    protocol: tcp
+    port: "443"
+    cidr: 0.0.0.0/0
+  - direction: ingress
+    protocol: udp
+    port: 51820
+    cidr: 0.0.0.0/0
+`), 0o600))
+
+	httpmock.RegisterResponder(http.MethodDelete,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/GRA11/securityGroup/fakeGroupID/rule/ruleSSH",
+		httpmock.NewStringResponder(200, ``))
+
+	httpmock.RegisterMatcherResponder(http.MethodPost,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/GRA11/securityGroup/fakeGroupID/rule",
+		tdhttpmock.JSONBody(td.JSON(`{
+			"direction": "ingress",
+			"ethertype": "IPv4",
+			"protocol": "udp",
+			"portRangeMin": 51820,
+			"portRangeMax": 51820,
+			"remoteIpPrefix": "0.0.0.0/0"
+		}`)),
+		httpmock.NewStringResponder(200, `{"id": "newRuleID"}`),
+	)
+
+	out, err := cmd.Execute("cloud", "security-group", "import", "GRA11", file, "--cloud-project", "fakeProjectID", "--json")
+	require.CmpNoError(err)
+	assert.Cmp(json.RawMessage(out), td.JSON(`{
+		"message": "✅ Security group web (ID: fakeGroupID) imported: 1 rule(s) added, 1 rule(s) removed",
+		"details": {
+			"id": "fakeGroupID",
+			"name": "web",
+			"rulesAdded": 1,
+			"rulesRemoved": 1
+		}
+	}`))
+}
+
+func (ms *MockSuite) TestCloudSecurityGroupExportImportRoundTripCmd(assert, require *td.T) {
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region",
+		httpmock.NewStringResponder(200, `["GRA11"]`))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/GRA11",
+		httpmock.NewStringResponder(200, `{
+			"name": "GRA11",
+			"type": "region",
+			"status": "UP",
+			"services": [
+				{
+					"name": "network",
+					"status": "UP"
+				}
+			]
+		}`))
+
+	// ICMP type and code are stored in the port range, and echo reply has type 0
+	group := `{
+		"id": "fakeGroupID",
+		"name": "routers",
+		"rules": [
+			{"id": "rulePing", "direction": "ingress", "ethertype": "IPv4", "protocol": "icmp", "portRangeMin": 8, "portRangeMax": 0, "remoteIpPrefix": "0.0.0.0/0"},
+			{"id": "ruleEchoReply", "direction": "ingress", "ethertype": "IPv4", "protocol": "icmp", "portRangeMin": 0, "remoteIpPrefix": "0.0.0.0/0"},
+			{"id": "ruleICMPv6", "direction": "ingress", "ethertype": "IPv6", "protocol": "ipv6-icmp", "remoteIpPrefix": "::/0"},
+			{"id": "ruleGRE", "direction": "ingress", "ethertype": "IPv4", "protocol": "gre", "remoteIpPrefix": "203.0.113.0/24"},
+			{"id": "ruleVRRP", "direction": "ingress", "ethertype": "IPv4", "protocol": "112", "remoteGroupId": "fakeGroupID"}
+		]
+	}`
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/GRA11/securityGroup",
+		httpmock.NewStringResponder(200, `[`+group+`]`))
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/GRA11/securityGroup/fakeGroupID",
+		httpmock.NewStringResponder(200, group))
+
+	file := filepath.Join(assert.TempDir(), "routers.yaml")
+	_, err := cmd.Execute("cloud", "security-group", "export", "fakeGroupID", "--file", file, "--cloud-project", "fakeProjectID")
+	require.CmpNoError(err)
+
+	content, err := os.ReadFile(file)
+	require.CmpNoError(err)
+	assert.String(string(content), `name: routers
+rules:
+- cidr: 0.0.0.0/0
+  direction: ingress
+  ethertype: IPv4
+  icmpCode: 0
+  icmpType: 8
+  protocol: icmp
+- cidr: 0.0.0.0/0
+  direction: ingress
+  ethertype: IPv4
+  icmpType: 0
+  protocol: icmp
+- cidr: ::/0
+  direction: ingress
+  ethertype: IPv6
+  protocol: ipv6-icmp
+- cidr: 203.0.113.0/24
+  direction: ingress
+  ethertype: IPv4
+  protocol: gre
+- direction: ingress
+  ethertype: IPv4
+  protocol: "112"
+  remoteGroup: fakeGroupID
+`)
+
+	// Importing the exported file back must not change anything
+	out, err := cmd.Execute("cloud", "security-group", "import", "GRA11", file, "--cloud-project", "fakeProjectID", "--json")
+	require.CmpNoError(err)
+	assert.Cmp(json.RawMessage(out), td.JSON(`{
+		"message": "✅ Security group routers (ID: fakeGroupID) imported: 0 rule(s) added, 0 rule(s) removed",
+		"details": {
+			"id": "fakeGroupID",
+			"name": "routers",
+			"rulesAdded": 0,
+			"rulesRemoved": 0
+		}
+	}`))
+}
+
+func (ms *MockSuite) TestCloudSecurityGroupImportNewGroupCmd(assert, require *td.T) {
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/SBG5/securityGroup",
+		httpmock.NewStringResponder(200, `[]`))
+
+	file := filepath.Join(assert.TempDir(), "web.yaml")
+	require.CmpNoError(os.WriteFile(file, []byte(`name: web
+rules:
+- direction: egress
+  ethertype: IPv4
+- direction: ingress
+  protocol: tcp
+  port: "22"
+  cidr: 0.0.0.0/0
+`), 0o600))
+
+	httpmock.RegisterMatcherResponder(http.MethodPost,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/SBG5/securityGroup",
+		tdhttpmock.JSONBody(td.JSON(`{"name": "web", "description": ""}`)),
+		httpmock.NewStringResponder(200, `{"id": "newGroupID", "name": "web"}`),
+	)
+
+	// New groups are created with the default egress rules
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/SBG5/securityGroup/newGroupID",
+		httpmock.NewStringResponder(200, `{
+			"id": "newGroupID",
+			"name": "web",
+			"rules": [
+				{"id": "ruleEgressIPv4", "direction": "egress", "ethertype": "IPv4"},
+				{"id": "ruleEgressIPv6", "direction": "egress", "ethertype": "IPv6"}
+			]
+		}`))
+
+	httpmock.RegisterResponder(http.MethodDelete,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/SBG5/securityGroup/newGroupID/rule/ruleEgressIPv6",
+		httpmock.NewStringResponder(200, ``))
+
+	httpmock.RegisterMatcherResponder(http.MethodPost,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/SBG5/securityGroup/newGroupID/rule",
+		tdhttpmock.JSONBody(td.JSON(`{
+			"direction": "ingress",
+			"ethertype": "IPv4",
+			"protocol": "tcp",
+			"portRangeMin": 22,
+			"portRangeMax": 22,
+			"remoteIpPrefix": "0.0.0.0/0"
+		}`)),
+		httpmock.NewStringResponder(200, `{"id": "newRuleID"}`),
+	)
+
+	out, err := cmd.Execute("cloud", "security-group", "import", "SBG5", file, "--cloud-project", "fakeProjectID", "--json")
+	require.CmpNoError(err)
+	assert.Cmp(json.RawMessage(out), td.JSON(`{
+		"message": "✅ Security group web (ID: newGroupID) imported: 1 rule(s) added, 1 rule(s) removed",
+		"details": {
+			"id": "newGroupID",
+			"name": "web",
+			"created": true,
+			"rulesAdded": 1,
+			"rulesRemoved": 1
+		}
+	}`))
+	assert.Cmp(httpmock.GetCallCountInfo()["DELETE https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/SBG5/securityGroup/newGroupID/rule/ruleEgressIPv6"], 1)
+}
@@ -120,6 +120,74 @@ func initCloudStorageS3Command(cloudCmd *cobra.Command) {
 		Args:  cobra.ExactArgs(2),
 	})
 
+	objectUploadCmd := &cobra.Command{
+		Use:   "upload <container_name> <object_name> <file>",
+		Short: "Upload a local file as an object in the given storage container",
+		Long: `Upload a local file as an object in the given storage container.
+
+Files smaller than the part size are uploaded in a single request using a presigned URL.
+Larger files are uploaded in parallel parts using a multipart upload, which requires S3 credentials:
+either use --s3-user to use (or generate) the S3 credentials of a Public Cloud user, or define
+the AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY environment variables.
+
+The checksum of each uploaded part is verified. Uploaded parts are recorded in a journal so that an
+interrupted upload is resumed when running the same command again.`,
+		Run:  cloud.StorageS3UploadObject,
+		Args: cobra.ExactArgs(3),
+	}
+	addStorageS3TransferFlags(objectUploadCmd)
+	objectUploadCmd.Flags().StringVar(&cloud.StorageS3TransferParams.StorageClass, "storage-class", "", "Storage class of the uploaded object (HIGH_PERF, STANDARD, STANDARD_IA)")
+	objectUploadCmd.Flags().StringVar(&cloud.StorageS3TransferParams.S3User, "s3-user", "", "ID of the user whose S3 credentials are used for multipart uploads")
+	objectCmd.AddCommand(objectUploadCmd)
+
+	objectDownloadCmd := &cobra.Command{
+		Use:   "download <container_name> <object_name> <file>",
+		Short: "Download an object from the given storage container to a local file",
+		Long: `Download an object from the given storage container to a local file.
+
+The object is downloaded in parallel ranged requests using a presigned URL. Data is written to
+<file>.part and moved to its final location once completed and its checksum verified.
+Downloaded parts are recorded in a journal so that an interrupted download is resumed when running
+the same command again.`,
+		Run:  cloud.StorageS3DownloadObject,
+		Args: cobra.ExactArgs(3),
+	}
+	addStorageS3TransferFlags(objectDownloadCmd)
+	objectDownloadCmd.Flags().StringVar(&cloud.StorageS3TransferParams.VersionId, "version-id", "", "Version ID of the object to download")
+	objectCmd.AddCommand(objectDownloadCmd)
+
+	// Sync command
+	syncCmd := &cobra.Command{
+		Use:   "sync <source> <destination>",
+		Short: "Synchronize a local directory with a prefix of a storage container",
+		Long: `Synchronize a local directory with a prefix of a storage container, in either direction.
+
+One of the source and destination must be a local directory and the other one a location formatted
+as s3://<container_name>/<prefix>. Files are compared using their size, ETag and modification time,
+and only the differences are transferred.
+
+Examples:
+  # Deploy a static website
+  ovhcloud cloud storage-s3 sync ./dist s3://my-container/website --delete
+
+  # Restore a backup, excluding temporary files
+  ovhcloud cloud storage-s3 sync s3://my-container/backups ./restore --exclude '*.tmp'
+
+Files larger than the part size are uploaded using a multipart upload, which requires S3 credentials
+(see "ovhcloud cloud storage-s3 object upload --help").`,
+		Run:  cloud.StorageS3Sync,
+		Args: cobra.ExactArgs(2),
+	}
+	addStorageS3TransferFlags(syncCmd)
+	syncCmd.Flags().StringVar(&cloud.StorageS3TransferParams.StorageClass, "storage-class", "", "Storage class of the uploaded objects (HIGH_PERF, STANDARD, STANDARD_IA)")
+	syncCmd.Flags().StringVar(&cloud.StorageS3TransferParams.S3User, "s3-user", "", "ID of the user whose S3 credentials are used for multipart uploads")
+	syncCmd.Flags().BoolVar(&cloud.StorageS3SyncParams.Delete, "delete", false, "Delete files in the destination that don't exist in the source")
+	syncCmd.Flags().BoolVar(&cloud.StorageS3SyncParams.DryRun, "dry-run", false, "Only display the changes that would be applied")
+	syncCmd.Flags().StringArrayVar(&cloud.StorageS3SyncParams.Include, "include", nil, "Only synchronize files matching the given glob pattern (can be repeated)")
+	syncCmd.Flags().StringArrayVar(&cloud.StorageS3SyncParams.Exclude, "exclude", nil, "Exclude files matching the given glob pattern (can be repeated)")
+	syncCmd.Flags().IntVar(&cloud.StorageS3SyncParams.Concurrency, "concurrency", 4, "Number of files transferred concurrently")
+	storageS3Cmd.AddCommand(syncCmd)
+
 	// Object version commands
 	objectVersionCmd := &cobra.Command{
 		Use:   "version",
@@ -226,9 +294,134 @@ func initCloudStorageS3Command(cloudCmd *cobra.Command) {
 		Args:  cobra.ExactArgs(2),
 	})
 
+	initCloudStorageS3ConfigurationCommands(storageS3Cmd)
+
 	cloudCmd.AddCommand(storageS3Cmd)
 }
 
+func initCloudStorageS3ConfigurationCommands(storageS3Cmd *cobra.Command) {
+	const documentHelp = `
+
+The document can be written in JSON or YAML, and is validated against the API schema before being sent.
+Use "-" as file name to read the document from the standard input.`
+
+	// Lifecycle commands
+	lifecycleCmd := &cobra.Command{
+		Use:   "lifecycle",
+		Short: "Manage the lifecycle configuration of the given storage container",
+	}
+	storageS3Cmd.AddCommand(lifecycleCmd)
+
+	lifecycleCmd.AddCommand(&cobra.Command{
+		Use:   "get <container_name>",
+		Short: "Get the lifecycle configuration of the given storage container",
+		Run:   cloud.GetStorageS3Lifecycle,
+		Args:  cobra.ExactArgs(1),
+	})
+
+	lifecycleCmd.AddCommand(&cobra.Command{
+		Use:   "set <container_name> <file>",
+		Short: "Set the lifecycle configuration of the given storage container",
+		Long:  "Set the lifecycle configuration of the given storage container." + documentHelp,
+		Run:   cloud.SetStorageS3Lifecycle,
+		Args:  cobra.ExactArgs(2),
+	})
+
+	lifecycleCmd.AddCommand(&cobra.Command{
+		Use:   "delete <container_name>",
+		Short: "Delete the lifecycle configuration of the given storage container",
+		Run:   cloud.DeleteStorageS3Lifecycle,
+		Args:  cobra.ExactArgs(1),
+	})
+
+	// CORS commands
+	corsCmd := &cobra.Command{
+		Use:   "cors",
+		Short: "Manage the CORS configuration of the given storage container",
+	}
+	storageS3Cmd.AddCommand(corsCmd)
+
+	corsCmd.AddCommand(&cobra.Command{
+		Use:   "get <container_name>",
+		Short: "Get the CORS configuration of the given storage container",
+		Run:   cloud.GetStorageS3CORS,
+		Args:  cobra.ExactArgs(1),
+	})
+
+	corsCmd.AddCommand(&cobra.Command{
+		Use:   "set <container_name> <file>",
+		Short: "Set the CORS configuration of the given storage container",
+		Long:  "Set the CORS configuration of the given storage container." + documentHelp,
+		Run:   cloud.SetStorageS3CORS,
+		Args:  cobra.ExactArgs(2),
+	})
+
+	// Versioning commands
+	versioningCmd := &cobra.Command{
+		Use:   "versioning",
+		Short: "Manage the versioning of the given storage container",
+	}
+	storageS3Cmd.AddCommand(versioningCmd)
+
+	versioningCmd.AddCommand(&cobra.Command{
+		Use:   "enable <container_name>",
+		Short: "Enable versioning of the given storage container",
+		Run:   cloud.EnableStorageS3Versioning,
+		Args:  cobra.ExactArgs(1),
+	})
+
+	versioningCmd.AddCommand(&cobra.Command{
+		Use:   "suspend <container_name>",
+		Short: "Suspend versioning of the given storage container",
+		Run:   cloud.SuspendStorageS3Versioning,
+		Args:  cobra.ExactArgs(1),
+	})
+
+	// Replication commands
+	replicationCmd := &cobra.Command{
+		Use:   "replication",
+		Short: "Manage the replication configuration of the given storage container",
+	}
+	storageS3Cmd.AddCommand(replicationCmd)
+
+	replicationCmd.AddCommand(&cobra.Command{
+		Use:   "get <container_name>",
+		Short: "Get the replication configuration of the given storage container",
+		Run:   cloud.GetStorageS3Replication,
+		Args:  cobra.ExactArgs(1),
+	})
+
+	replicationCmd.AddCommand(&cobra.Command{
+		Use:   "set <container_name> <file>",
+		Short: "Set the replication configuration of the given storage container",
+		Long:  "Set the replication configuration of the given storage container." + documentHelp,
+		Run:   cloud.SetStorageS3Replication,
+		Args:  cobra.ExactArgs(2),
+	})
+
+	// Object lock commands
+	objectLockCmd := &cobra.Command{
+		Use:   "object-lock",
+		Short: "Manage the object lock configuration of the given storage container",
+	}
+	storageS3Cmd.AddCommand(objectLockCmd)
+
+	objectLockCmd.AddCommand(&cobra.Command{
+		Use:   "get <container_name>",
+		Short: "Get the object lock configuration of the given storage container",
+		Run:   cloud.GetStorageS3ObjectLock,
+		Args:  cobra.ExactArgs(1),
+	})
+
+	objectLockCmd.AddCommand(&cobra.Command{
+		Use:   "set <container_name> <file>",
+		Short: "Set the object lock configuration of the given storage container",
+		Long:  "Set the object lock configuration of the given storage container." + documentHelp,
+		Run:   cloud.SetStorageS3ObjectLock,
+		Args:  cobra.ExactArgs(2),
+	})
+}
+
 func getCloudStorageS3CreateCmd() *cobra.Command {
 	s3CreateCmd := &cobra.Command{
 		Use:   "create <region>",
@@ -293,3 +486,10 @@ There are three ways to define the creation parameters:
 
 	return s3CreateCmd
 }
+
+func addStorageS3TransferFlags(cmd *cobra.Command) {
+	cmd.Flags().IntVar(&cloud.StorageS3TransferParams.PartSize, "part-size", 64, "Size of the transferred parts in MiB")
+	cmd.Flags().IntVar(&cloud.StorageS3TransferParams.Parallel, "parallel", 4, "Number of parts transferred in parallel")
+	cmd.Flags().IntVar(&cloud.StorageS3TransferParams.Retries, "retries", 3, "Number of retries of a failed request")
+	cmd.Flags().BoolVar(&cloud.StorageS3TransferParams.NoResume, "no-resume", false, "Ignore the journal of a previous interrupted transfer and start over")
+}
@@ -5,8 +5,18 @@
 package cmd_test
 
 import (
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
+	"fmt"
+	"io"
 	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
 
 	"github.com/jarcoal/httpmock"
 	"github.com/maxatome/go-testdeep/td"
@@ -161,3 +171,723 @@ func (ms *MockSuite) TestCloudStorageS3BulkDeleteAllCmd(assert, require *td.T) {
 	require.CmpNoError(err)
 	assert.Cmp(json.RawMessage(out), td.JSON(`{"message": "✅ Objects deleted successfully"}`))
 }
+
+func (ms *MockSuite) TestCloudStorageS3ObjectUploadCmd(assert, require *td.T) {
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region",
+		httpmock.NewStringResponder(200, `["BHS"]`))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/BHS",
+		httpmock.NewStringResponder(200, `{
+			"name": "BHS",
+			"type": "region",
+			"status": "UP",
+			"services": [
+				{
+					"name": "storage-s3-high-perf",
+					"status": "UP"
+				}
+			]
+		}`))
+
+	httpmock.RegisterResponder(http.MethodGet,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/BHS/storage/fakeContainer",
+		httpmock.NewStringResponder(200, `{
+			"name": "fakeContainer",
+			"virtualHost": "https://fakeContainer.test.ovh.net/",
+			"region": "BHS",
+			"createdAt": "2025-02-10T14:24:12Z"
+		}`))
+
+	content := "hello world"
+	checksum := md5.Sum([]byte(content))
+	filePath := filepath.Join(assert.TempDir(), "artifact.txt")
+	require.CmpNoError(os.WriteFile(filePath, []byte(content), 0o600))
+
+	httpmock.RegisterMatcherResponder(http.MethodPost,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/BHS/storage/fakeContainer/presign",
+		tdhttpmock.JSONBody(td.JSON(`
+			{
+				"expire": 3600,
+				"method": "PUT",
+				"object": "artifacts/artifact.txt"
+			}`),
+		),
+		httpmock.NewStringResponder(200, `{
+			"method": "PUT",
+			"url": "https://fakeContainer.test.ovh.net/artifacts/artifact.txt?X-Amz-Signature=fake",
+			"signedHeaders": {"Host": "fakeContainer.test.ovh.net"}
+		}`),
+	)
+
+	httpmock.RegisterResponder(http.MethodPut, "https://fakeContainer.test.ovh.net/artifacts/artifact.txt",
+		func(req *http.Request) (*http.Response, error) {
+			body, err := io.ReadAll(req.Body)
+			if err != nil || string(body) != content {
+				return httpmock.NewStringResponse(400, "invalid body"), nil
+			}
+			resp := httpmock.NewStringResponse(200, "")
+			resp.Header.Set("ETag", `"`+hex.EncodeToString(checksum[:])+`"`)
+			return resp, nil
+		})
+
+	out, err := cmd.Execute("cloud", "storage-s3", "object", "upload", "fakeContainer", "artifacts/artifact.txt", filePath, "--cloud-project", "fakeProjectID", "--json")
+	require.CmpNoError(err)
+	assert.Cmp(json.RawMessage(out), td.JSON(`{
+		"message": $message,
+		"details": {
+			"container": "fakeContainer",
+			"key": "artifacts/artifact.txt",
+			"size": 11,
+			"etag": $etag
+		}
+	}`,
+		td.Tag("message", fmt.Sprintf("✅ File %s uploaded successfully to fakeContainer/artifacts/artifact.txt", filePath)),
+		td.Tag("etag", hex.EncodeToString(checksum[:])),
+	))
+}
+
+func (ms *MockSuite) TestCloudStorageS3ObjectUploadMultipartCmd(assert, require *td.T) {
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region",
+		httpmock.NewStringResponder(200, `["BHS"]`))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/BHS",
+		httpmock.NewStringResponder(200, `{
+			"name": "BHS",
+			"type": "region",
+			"status": "UP",
+			"services": [
+				{
+					"name": "storage-s3-high-perf",
+					"status": "UP"
+				}
+			]
+		}`))
+
+	httpmock.RegisterResponder(http.MethodGet,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/BHS/storage/fakeContainer",
+		httpmock.NewStringResponder(200, `{
+			"name": "fakeContainer",
+			"virtualHost": "https://fakeContainer.test.ovh.net/",
+			"region": "BHS",
+			"createdAt": "2025-02-10T14:24:12Z"
+		}`))
+	cacheDir := assert.TempDir()
+	assert.Setenv("XDG_CACHE_HOME", cacheDir)
+	assert.Setenv("AWS_ACCESS_KEY_ID", "fakeAccess")
+	assert.Setenv("AWS_SECRET_ACCESS_KEY", "fakeSecret")
+
+	// File spanning two parts of 5 MiB
+	content := strings.Repeat("0123456789abcdef", 384*1024)
+	filePath := filepath.Join(assert.TempDir(), "big.bin")
+	require.CmpNoError(os.WriteFile(filePath, []byte(content), 0o600))
+	firstPart, secondPart := md5.Sum([]byte(content[:5*1024*1024])), md5.Sum([]byte(content[5*1024*1024:]))
+
+	var (
+		mu             sync.Mutex
+		initiated      int
+		uploadedParts  []string
+		completionBody string
+	)
+	httpmock.RegisterResponder(http.MethodPost, "https://fakeContainer.test.ovh.net/backups/big.bin",
+		func(req *http.Request) (*http.Response, error) {
+			if !strings.HasPrefix(req.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=fakeAccess/") {
+				return httpmock.NewStringResponse(403, "unsigned request"), nil
+			}
+			if req.URL.Query().Has("uploads") {
+				initiated++
+				return httpmock.NewStringResponse(200, `<InitiateMultipartUploadResult><UploadId>fakeUploadID</UploadId></InitiateMultipartUploadResult>`), nil
+			}
+			body, _ := io.ReadAll(req.Body)
+			completionBody = string(body)
+			return httpmock.NewStringResponse(200, `<CompleteMultipartUploadResult><ETag>"fakeETag-2"</ETag></CompleteMultipartUploadResult>`), nil
+		})
+
+	httpmock.RegisterResponder(http.MethodPut, "https://fakeContainer.test.ovh.net/backups/big.bin",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := io.ReadAll(req.Body)
+			checksum := md5.Sum(body)
+			if req.URL.Query().Get("uploadId") != "fakeUploadID" || req.Header.Get("Content-MD5") != base64.StdEncoding.EncodeToString(checksum[:]) {
+				return httpmock.NewStringResponse(400, "invalid part"), nil
+			}
+
+			mu.Lock()
+			uploadedParts = append(uploadedParts, req.URL.Query().Get("partNumber"))
+			mu.Unlock()
+
+			resp := httpmock.NewStringResponse(200, "")
+			resp.Header.Set("ETag", `"`+hex.EncodeToString(checksum[:])+`"`)
+			return resp, nil
+		})
+
+	out, err := cmd.Execute("cloud", "storage-s3", "object", "upload", "fakeContainer", "backups/big.bin", filePath, "--part-size", "5", "--cloud-project", "fakeProjectID", "--json")
+	require.CmpNoError(err)
+	assert.Cmp(json.RawMessage(out), td.JSON(`{
+		"message": $message,
+		"details": {
+			"container": "fakeContainer",
+			"key": "backups/big.bin",
+			"size": 6291456,
+			"etag": "fakeETag-2"
+		}
+	}`,
+		td.Tag("message", fmt.Sprintf("✅ File %s uploaded successfully to fakeContainer/backups/big.bin", filePath)),
+	))
+
+	assert.Cmp(initiated, 1)
+	assert.Cmp(uploadedParts, td.Bag("1", "2"))
+	assert.String(completionBody, fmt.Sprintf(
+		"<CompleteMultipartUpload><Part><PartNumber>1</PartNumber><ETag>&#34;%s&#34;</ETag></Part><Part><PartNumber>2</PartNumber><ETag>&#34;%s&#34;</ETag></Part></CompleteMultipartUpload>",
+		hex.EncodeToString(firstPart[:]), hex.EncodeToString(secondPart[:])))
+
+	// The journal is removed once the upload is completed
+	journals, err := os.ReadDir(filepath.Join(cacheDir, "ovhcloud-cli", "transfers"))
+	require.CmpNoError(err)
+	assert.Len(journals, 0)
+}
+
+func (ms *MockSuite) TestCloudStorageS3ObjectUploadResumeCmd(assert, require *td.T) {
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region",
+		httpmock.NewStringResponder(200, `["BHS"]`))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/BHS",
+		httpmock.NewStringResponder(200, `{
+			"name": "BHS",
+			"type": "region",
+			"status": "UP",
+			"services": [
+				{
+					"name": "storage-s3-high-perf",
+					"status": "UP"
+				}
+			]
+		}`))
+
+	httpmock.RegisterResponder(http.MethodGet,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/BHS/storage/fakeContainer",
+		httpmock.NewStringResponder(200, `{
+			"name": "fakeContainer",
+			"virtualHost": "https://fakeContainer.test.ovh.net/",
+			"region": "BHS",
+			"createdAt": "2025-02-10T14:24:12Z"
+		}`))
+	cacheDir := assert.TempDir()
+	assert.Setenv("XDG_CACHE_HOME", cacheDir)
+	assert.Setenv("AWS_ACCESS_KEY_ID", "fakeAccess")
+	assert.Setenv("AWS_SECRET_ACCESS_KEY", "fakeSecret")
+
+	content := strings.Repeat("0123456789abcdef", 384*1024)
+	filePath := filepath.Join(assert.TempDir(), "big.bin")
+	require.CmpNoError(os.WriteFile(filePath, []byte(content), 0o600))
+	firstPart, secondPart := md5.Sum([]byte(content[:5*1024*1024])), md5.Sum([]byte(content[5*1024*1024:]))
+
+	// Journal left by an interrupted upload, in which the first part was uploaded
+	info, err := os.Stat(filePath)
+	require.CmpNoError(err)
+	journalID := sha256.Sum256([]byte("upload\nfakeContainer\nbackups/big.bin\n" + filePath))
+	journalDir := filepath.Join(cacheDir, "ovhcloud-cli", "transfers")
+	require.CmpNoError(os.MkdirAll(journalDir, 0o700))
+	require.CmpNoError(os.WriteFile(filepath.Join(journalDir, hex.EncodeToString(journalID[:])+".json"), []byte(fmt.Sprintf(
+		`{"uploadId": "fakeUploadID", "size": %d, "modTime": %d, "partSize": 5242880, "parts": {"1": "%s"}}`,
+		info.Size(), info.ModTime().UnixNano(), hex.EncodeToString(firstPart[:]))), 0o600))
+
+	var (
+		initiated      int
+		uploadedParts  []string
+		completionBody string
+	)
+	httpmock.RegisterResponder(http.MethodPost, "https://fakeContainer.test.ovh.net/backups/big.bin",
+		func(req *http.Request) (*http.Response, error) {
+			if req.URL.Query().Has("uploads") {
+				initiated++
+				return httpmock.NewStringResponse(200, `<InitiateMultipartUploadResult><UploadId>otherUploadID</UploadId></InitiateMultipartUploadResult>`), nil
+			}
+			body, _ := io.ReadAll(req.Body)
+			completionBody = string(body)
+			return httpmock.NewStringResponse(200, `<CompleteMultipartUploadResult><ETag>"fakeETag-2"</ETag></CompleteMultipartUploadResult>`), nil
+		})
+
+	httpmock.RegisterResponder(http.MethodPut, "https://fakeContainer.test.ovh.net/backups/big.bin",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := io.ReadAll(req.Body)
+			checksum := md5.Sum(body)
+			if req.URL.Query().Get("uploadId") != "fakeUploadID" {
+				return httpmock.NewStringResponse(404, "unknown upload"), nil
+			}
+			uploadedParts = append(uploadedParts, req.URL.Query().Get("partNumber"))
+
+			resp := httpmock.NewStringResponse(200, "")
+			resp.Header.Set("ETag", `"`+hex.EncodeToString(checksum[:])+`"`)
+			return resp, nil
+		})
+
+	out, err := cmd.Execute("cloud", "storage-s3", "object", "upload", "fakeContainer", "backups/big.bin", filePath, "--part-size", "5", "--cloud-project", "fakeProjectID", "--json")
+	require.CmpNoError(err)
+	assert.Cmp(json.RawMessage(out), td.JSON(`{"message": $message, "details": {"container": "fakeContainer", "key": "backups/big.bin", "size": 6291456, "etag": "fakeETag-2"}}`,
+		td.Tag("message", fmt.Sprintf("✅ File %s uploaded successfully to fakeContainer/backups/big.bin", filePath)),
+	))
+
+	// Only the missing part is uploaded, in the upload started by the previous attempt
+	assert.Cmp(initiated, 0)
+	assert.Cmp(uploadedParts, []string{"2"})
+	assert.String(completionBody, fmt.Sprintf(
+		"<CompleteMultipartUpload><Part><PartNumber>1</PartNumber><ETag>&#34;%s&#34;</ETag></Part><Part><PartNumber>2</PartNumber><ETag>&#34;%s&#34;</ETag></Part></CompleteMultipartUpload>",
+		hex.EncodeToString(firstPart[:]), hex.EncodeToString(secondPart[:])))
+}
+
+func (ms *MockSuite) TestCloudStorageS3ObjectDownloadCmd(assert, require *td.T) {
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region",
+		httpmock.NewStringResponder(200, `["BHS"]`))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/BHS",
+		httpmock.NewStringResponder(200, `{
+			"name": "BHS",
+			"type": "region",
+			"status": "UP",
+			"services": [
+				{
+					"name": "storage-s3-high-perf",
+					"status": "UP"
+				}
+			]
+		}`))
+
+	httpmock.RegisterResponder(http.MethodGet,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/BHS/storage/fakeContainer",
+		httpmock.NewStringResponder(200, `{
+			"name": "fakeContainer",
+			"virtualHost": "https://fakeContainer.test.ovh.net/",
+			"region": "BHS",
+			"createdAt": "2025-02-10T14:24:12Z"
+		}`))
+	assert.Setenv("XDG_CACHE_HOME", assert.TempDir())
+
+	// Object spanning three parts of 1 MiB
+	content := strings.Repeat("0123456789abcdef", 160*1024)
+	checksum := md5.Sum([]byte(content))
+	etag := hex.EncodeToString(checksum[:])
+	filePath := filepath.Join(assert.TempDir(), "artifact.bin")
+
+	httpmock.RegisterMatcherResponder(http.MethodPost,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/BHS/storage/fakeContainer/presign",
+		tdhttpmock.JSONBody(td.JSON(`
+			{
+				"expire": 3600,
+				"method": "GET",
+				"object": "artifact.bin"
+			}`),
+		),
+		httpmock.NewStringResponder(200, `{
+			"method": "GET",
+			"url": "https://fakeContainer.test.ovh.net/artifact.bin?X-Amz-Signature=fake",
+			"signedHeaders": {}
+		}`),
+	)
+
+	httpmock.RegisterResponder(http.MethodGet, "https://fakeContainer.test.ovh.net/artifact.bin",
+		func(req *http.Request) (*http.Response, error) {
+			var start, end int
+			if _, err := fmt.Sscanf(req.Header.Get("Range"), "bytes=%d-%d", &start, &end); err != nil {
+				return httpmock.NewStringResponse(400, "invalid range"), nil
+			}
+			resp := httpmock.NewStringResponse(206, content[start:end+1])
+			resp.Header.Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", start, end, len(content)))
+			resp.Header.Set("ETag", `"`+etag+`"`)
+			return resp, nil
+		})
+
+	out, err := cmd.Execute("cloud", "storage-s3", "object", "download", "fakeContainer", "artifact.bin", filePath, "--part-size", "1", "--cloud-project", "fakeProjectID", "--json")
+	require.CmpNoError(err)
+	assert.Cmp(json.RawMessage(out), td.JSON(`{
+		"message": $message,
+		"details": {
+			"container": "fakeContainer",
+			"key": "artifact.bin",
+			"size": 2621440,
+			"etag": $etag,
+			"file": $file
+		}
+	}`,
+		td.Tag("message", fmt.Sprintf("✅ Object fakeContainer/artifact.bin downloaded successfully to %s", filePath)),
+		td.Tag("etag", etag),
+		td.Tag("file", filePath),
+	))
+
+	downloaded, err := os.ReadFile(filePath)
+	require.CmpNoError(err)
+	assert.True(string(downloaded) == content)
+}
+
+func (ms *MockSuite) TestCloudStorageS3ObjectDownloadEmptyCmd(assert, require *td.T) {
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region",
+		httpmock.NewStringResponder(200, `["BHS"]`))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/BHS",
+		httpmock.NewStringResponder(200, `{
+			"name": "BHS",
+			"type": "region",
+			"status": "UP",
+			"services": [
+				{
+					"name": "storage-s3-high-perf",
+					"status": "UP"
+				}
+			]
+		}`))
+
+	httpmock.RegisterResponder(http.MethodGet,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/BHS/storage/fakeContainer",
+		httpmock.NewStringResponder(200, `{
+			"name": "fakeContainer",
+			"virtualHost": "https://fakeContainer.test.ovh.net/",
+			"region": "BHS",
+			"createdAt": "2025-02-10T14:24:12Z"
+		}`))
+	filePath := filepath.Join(assert.TempDir(), "empty.txt")
+
+	httpmock.RegisterMatcherResponder(http.MethodPost,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/BHS/storage/fakeContainer/presign",
+		tdhttpmock.JSONBody(td.JSON(`{"expire": 3600, "method": "GET", "object": "empty.txt"}`)),
+		httpmock.NewStringResponder(200, `{
+			"method": "GET",
+			"url": "https://fakeContainer.test.ovh.net/empty.txt?X-Amz-Signature=fake",
+			"signedHeaders": {}
+		}`),
+	)
+
+	// S3 cannot satisfy any range on an empty object
+	httpmock.RegisterResponder(http.MethodGet, "https://fakeContainer.test.ovh.net/empty.txt",
+		func(req *http.Request) (*http.Response, error) {
+			resp := httpmock.NewStringResponse(416, "<Error><Code>InvalidRange</Code></Error>")
+			resp.Header.Set("Content-Range", "bytes */0")
+			return resp, nil
+		})
+
+	out, err := cmd.Execute("cloud", "storage-s3", "object", "download", "fakeContainer", "empty.txt", filePath, "--cloud-project", "fakeProjectID", "--json")
+	require.CmpNoError(err)
+	assert.Cmp(json.RawMessage(out), td.JSON(`{"message": $message, "details": {"container": "fakeContainer", "key": "empty.txt", "size": 0, "etag": "", "file": $file}}`,
+		td.Tag("message", fmt.Sprintf("✅ Object fakeContainer/empty.txt downloaded successfully to %s", filePath)),
+		td.Tag("file", filePath),
+	))
+
+	downloaded, err := os.ReadFile(filePath)
+	require.CmpNoError(err)
+	assert.Len(downloaded, 0)
+}
+
+func (ms *MockSuite) TestCloudStorageS3SyncUploadCmd(assert, require *td.T) {
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region",
+		httpmock.NewStringResponder(200, `["BHS"]`))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/BHS",
+		httpmock.NewStringResponder(200, `{
+			"name": "BHS",
+			"type": "region",
+			"status": "UP",
+			"services": [
+				{
+					"name": "storage-s3-high-perf",
+					"status": "UP"
+				}
+			]
+		}`))
+
+	httpmock.RegisterResponder(http.MethodGet,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/BHS/storage/fakeContainer",
+		httpmock.NewStringResponder(200, `{
+			"name": "fakeContainer",
+			"virtualHost": "https://fakeContainer.test.ovh.net/",
+			"region": "BHS",
+			"createdAt": "2025-02-10T14:24:12Z"
+		}`))
+
+	dir := assert.TempDir()
+	require.CmpNoError(os.MkdirAll(filepath.Join(dir, "css"), 0o755))
+	require.CmpNoError(os.WriteFile(filepath.Join(dir, "index.html"), []byte("<html></html>"), 0o600))
+	require.CmpNoError(os.WriteFile(filepath.Join(dir, "css", "style.css"), []byte("body {}"), 0o600))
+	require.CmpNoError(os.WriteFile(filepath.Join(dir, "notes.tmp"), []byte("draft"), 0o600))
+
+	unchanged := md5.Sum([]byte("<html></html>"))
+	httpmock.RegisterResponder(http.MethodGet,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/BHS/storage/fakeContainer/object?limit=1000&prefix=website%2F",
+		httpmock.NewStringResponder(200, fmt.Sprintf(`[
+			{"key": "website/index.html", "size": 13, "etag": "\"%s\"", "lastModified": "2025-02-10T14:24:12Z"},
+			{"key": "website/old.html", "size": 10, "etag": "\"abc\"", "lastModified": "2025-02-10T14:24:12Z"}
+		]`, hex.EncodeToString(unchanged[:]))))
+	httpmock.RegisterResponder(http.MethodGet,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/BHS/storage/fakeContainer/object?keyMarker=website%2Fold.html&limit=1000&prefix=website%2F",
+		httpmock.NewStringResponder(200, `[]`))
+
+	httpmock.RegisterMatcherResponder(http.MethodPost,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/BHS/storage/fakeContainer/presign",
+		tdhttpmock.JSONBody(td.JSON(`{"expire": 3600, "method": "PUT", "object": "website/css/style.css"}`)),
+		httpmock.NewStringResponder(200, `{
+			"method": "PUT",
+			"url": "https://fakeContainer.test.ovh.net/website/css/style.css?X-Amz-Signature=fake"
+		}`),
+	)
+
+	httpmock.RegisterResponder(http.MethodPut, "https://fakeContainer.test.ovh.net/website/css/style.css",
+		httpmock.NewStringResponder(200, ""))
+
+	httpmock.RegisterMatcherResponder(http.MethodPost,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/BHS/storage/fakeContainer/bulkDeleteObjects",
+		tdhttpmock.JSONBody(td.JSON(`{"objects": [{"key": "website/old.html"}]}`)),
+		httpmock.NewStringResponder(200, ``),
+	)
+
+	out, err := cmd.Execute("cloud", "storage-s3", "sync", dir, "s3://fakeContainer/website", "--delete", "--exclude", "*.tmp", "--cloud-project", "fakeProjectID", "--json")
+	require.CmpNoError(err)
+	assert.Cmp(json.RawMessage(out), td.JSON(`{
+		"message": "✅ Sync done: 1 file(s) transferred, 1 deleted, 1 already up to date",
+		"details": {
+			"transferred": ["css/style.css"],
+			"deleted": ["old.html"],
+			"skipped": 1
+		}
+	}`))
+}
+
+func (ms *MockSuite) TestCloudStorageS3SyncDownloadCmd(assert, require *td.T) {
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region",
+		httpmock.NewStringResponder(200, `["BHS"]`))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/BHS",
+		httpmock.NewStringResponder(200, `{
+			"name": "BHS",
+			"type": "region",
+			"status": "UP",
+			"services": [
+				{
+					"name": "storage-s3-high-perf",
+					"status": "UP"
+				}
+			]
+		}`))
+
+	httpmock.RegisterResponder(http.MethodGet,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/BHS/storage/fakeContainer",
+		httpmock.NewStringResponder(200, `{
+			"name": "fakeContainer",
+			"virtualHost": "https://fakeContainer.test.ovh.net/",
+			"region": "BHS",
+			"createdAt": "2025-02-10T14:24:12Z"
+		}`))
+
+	parent := assert.TempDir()
+	dir := filepath.Join(parent, "website")
+	require.CmpNoError(os.MkdirAll(dir, 0o755))
+
+	content := "<html></html>"
+	checksum := md5.Sum([]byte(content))
+	etag := hex.EncodeToString(checksum[:])
+
+	httpmock.RegisterResponder(http.MethodGet,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/BHS/storage/fakeContainer/object?limit=1000&prefix=website%2F",
+		httpmock.NewStringResponder(200, fmt.Sprintf(`[
+			{"key": "website/pages/index.html", "size": 13, "etag": "\"%s\"", "lastModified": "2025-02-10T14:24:12Z"},
+			{"key": "website/../../escape.html", "size": 13, "etag": "\"%s\"", "lastModified": "2025-02-10T14:24:12Z"}
+		]`, etag, etag)))
+	httpmock.RegisterResponder(http.MethodGet,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/BHS/storage/fakeContainer/object?keyMarker=website%2F..%2F..%2Fescape.html&limit=1000&prefix=website%2F",
+		httpmock.NewStringResponder(200, `[]`))
+
+	httpmock.RegisterMatcherResponder(http.MethodPost,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/BHS/storage/fakeContainer/presign",
+		tdhttpmock.JSONBody(td.JSON(`{"expire": 3600, "method": "GET", "object": "website/pages/index.html"}`)),
+		httpmock.NewStringResponder(200, `{
+			"method": "GET",
+			"url": "https://fakeContainer.test.ovh.net/website/pages/index.html?X-Amz-Signature=fake",
+			"signedHeaders": {}
+		}`),
+	)
+
+	httpmock.RegisterResponder(http.MethodGet, "https://fakeContainer.test.ovh.net/website/pages/index.html",
+		func(req *http.Request) (*http.Response, error) {
+			resp := httpmock.NewStringResponse(200, content)
+			resp.Header.Set("ETag", `"`+etag+`"`)
+			return resp, nil
+		})
+
+	out, err := cmd.Execute("cloud", "storage-s3", "sync", "s3://fakeContainer/website", dir, "--cloud-project", "fakeProjectID", "--json")
+	require.CmpNoError(err)
+	assert.Cmp(json.RawMessage(out), td.JSON(`{
+		"message": "✅ Sync done: 1 file(s) transferred, 0 deleted, 0 already up to date",
+		"details": {
+			"transferred": ["pages/index.html"],
+			"deleted": null,
+			"skipped": 0
+		}
+	}`))
+
+	downloaded, err := os.ReadFile(filepath.Join(dir, "pages", "index.html"))
+	require.CmpNoError(err)
+	assert.String(string(downloaded), content)
+
+	// Keys leading outside of the local directory are never written
+	_, err = os.Stat(filepath.Join(parent, "..", "escape.html"))
+	assert.True(os.IsNotExist(err))
+	_, err = os.Stat(filepath.Join(parent, "escape.html"))
+	assert.True(os.IsNotExist(err))
+}
+
+func (ms *MockSuite) TestCloudStorageS3SyncDownloadShortPageCmd(assert, require *td.T) {
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region",
+		httpmock.NewStringResponder(200, `["BHS"]`))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/BHS",
+		httpmock.NewStringResponder(200, `{
+			"name": "BHS",
+			"type": "region",
+			"status": "UP",
+			"services": [
+				{
+					"name": "storage-s3-high-perf",
+					"status": "UP"
+				}
+			]
+		}`))
+
+	httpmock.RegisterResponder(http.MethodGet,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/BHS/storage/fakeContainer",
+		httpmock.NewStringResponder(200, `{
+			"name": "fakeContainer",
+			"virtualHost": "https://fakeContainer.test.ovh.net/",
+			"region": "BHS",
+			"createdAt": "2025-02-10T14:24:12Z"
+		}`))
+
+	dir := assert.TempDir()
+	require.CmpNoError(os.WriteFile(filepath.Join(dir, "a.html"), []byte("a"), 0o600))
+	require.CmpNoError(os.WriteFile(filepath.Join(dir, "b.html"), []byte("b"), 0o600))
+	require.CmpNoError(os.WriteFile(filepath.Join(dir, "stale.html"), []byte("stale"), 0o600))
+
+	etagA := md5.Sum([]byte("a"))
+	etagB := md5.Sum([]byte("b"))
+
+	// The first page is shorter than the limit, but the listing goes on until an empty page
+	httpmock.RegisterResponder(http.MethodGet,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/BHS/storage/fakeContainer/object?limit=1000&prefix=website%2F",
+		httpmock.NewStringResponder(200, fmt.Sprintf(`[
+			{"key": "website/a.html", "size": 1, "etag": "\"%s\"", "lastModified": "2025-02-10T14:24:12Z"}
+		]`, hex.EncodeToString(etagA[:]))))
+	httpmock.RegisterResponder(http.MethodGet,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/BHS/storage/fakeContainer/object?keyMarker=website%2Fa.html&limit=1000&prefix=website%2F",
+		httpmock.NewStringResponder(200, fmt.Sprintf(`[
+			{"key": "website/b.html", "size": 1, "etag": "\"%s\"", "lastModified": "2025-02-10T14:24:12Z"}
+		]`, hex.EncodeToString(etagB[:]))))
+	httpmock.RegisterResponder(http.MethodGet,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/BHS/storage/fakeContainer/object?keyMarker=website%2Fb.html&limit=1000&prefix=website%2F",
+		httpmock.NewStringResponder(200, `[]`))
+
+	out, err := cmd.Execute("cloud", "storage-s3", "sync", "s3://fakeContainer/website", dir, "--delete", "--cloud-project", "fakeProjectID", "--json")
+	require.CmpNoError(err)
+	assert.Cmp(json.RawMessage(out), td.JSON(`{
+		"message": "✅ Sync done: 0 file(s) transferred, 1 deleted, 2 already up to date",
+		"details": {
+			"transferred": null,
+			"deleted": ["stale.html"],
+			"skipped": 2
+		}
+	}`))
+
+	_, err = os.Stat(filepath.Join(dir, "b.html"))
+	assert.CmpNoError(err)
+	_, err = os.Stat(filepath.Join(dir, "stale.html"))
+	assert.True(os.IsNotExist(err))
+}
+
+func (ms *MockSuite) TestCloudStorageS3VersioningEnableCmd(assert, require *td.T) {
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region",
+		httpmock.NewStringResponder(200, `["BHS"]`))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/BHS",
+		httpmock.NewStringResponder(200, `{
+			"name": "BHS",
+			"type": "region",
+			"status": "UP",
+			"services": [
+				{
+					"name": "storage-s3-high-perf",
+					"status": "UP"
+				}
+			]
+		}`))
+
+	httpmock.RegisterResponder(http.MethodGet,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/BHS/storage/fakeContainer",
+		httpmock.NewStringResponder(200, `{
+			"name": "fakeContainer",
+			"virtualHost": "https://fakeContainer.test.ovh.net/",
+			"region": "BHS",
+			"createdAt": "2025-02-10T14:24:12Z"
+		}`))
+
+	httpmock.RegisterMatcherResponder(http.MethodPut,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/BHS/storage/fakeContainer",
+		tdhttpmock.JSONBody(td.JSON(`{"versioning": {"status": "enabled"}}`)),
+		httpmock.NewStringResponder(200, ``),
+	)
+
+	out, err := cmd.Execute("cloud", "storage-s3", "versioning", "enable", "fakeContainer", "--cloud-project", "fakeProjectID", "--json")
+	require.CmpNoError(err)
+	assert.Cmp(json.RawMessage(out), td.JSON(`{"message": "✅ Versioning of container fakeContainer is now enabled"}`))
+}
+
+func (ms *MockSuite) TestCloudStorageS3LifecycleSetCmd(assert, require *td.T) {
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region",
+		httpmock.NewStringResponder(200, `["BHS"]`))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/BHS",
+		httpmock.NewStringResponder(200, `{
+			"name": "BHS",
+			"type": "region",
+			"status": "UP",
+			"services": [
+				{
+					"name": "storage-s3-high-perf",
+					"status": "UP"
+				}
+			]
+		}`))
+
+	httpmock.RegisterResponder(http.MethodGet,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/BHS/storage/fakeContainer",
+		httpmock.NewStringResponder(200, `{
+			"name": "fakeContainer",
+			"virtualHost": "https://fakeContainer.test.ovh.net/",
+			"region": "BHS",
+			"createdAt": "2025-02-10T14:24:12Z"
+		}`))
+
+	documentPath := filepath.Join(assert.TempDir(), "lifecycle.yaml")
+	require.CmpNoError(os.WriteFile(documentPath, []byte(`
+rules:
+  - id: expire-logs
+    status: enabled
+    filter:
+      prefix: logs/
+    expiration:
+      days: 30
+`), 0o600))
+
+	httpmock.RegisterMatcherResponder(http.MethodPut,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/BHS/storage/fakeContainer/lifecycle",
+		tdhttpmock.JSONBody(td.JSON(`
+			{
+				"rules": [
+					{
+						"id": "expire-logs",
+						"status": "enabled",
+						"filter": {"prefix": "logs/"},
+						"expiration": {"days": 30}
+					}
+				]
+			}`),
+		),
+		httpmock.NewStringResponder(200, ``),
+	)
+
+	out, err := cmd.Execute("cloud", "storage-s3", "lifecycle", "set", "fakeContainer", documentPath, "--cloud-project", "fakeProjectID", "--json")
+	require.CmpNoError(err)
+	assert.Cmp(json.RawMessage(out), td.JSON(`{"message": "✅ Lifecycle configuration of container fakeContainer updated successfully"}`))
+}
@@ -79,5 +79,39 @@ func initCloudUserCommand(cloudCmd *cobra.Command) {
 	s3PolicyCreateCmd.MarkFlagsMutuallyExclusive("policy", "from-file", "editor")
 	s3PolicyCmd.AddCommand(s3PolicyCreateCmd)
 
+	// S3 credentials commands
+	s3CredentialsCmd := &cobra.Command{
+		Use:   "s3-credentials",
+		Short: "Manage S3™* credentials of users (* S3 is a trademark filed by Amazon Technologies,Inc. OVHcloud's service is not sponsored by, endorsed by, or otherwise affiliated with Amazon Technologies,Inc.)",
+	}
+	userCmd.AddCommand(s3CredentialsCmd)
+
+	s3CredentialsRotateCmd := &cobra.Command{
+		Use:   "rotate <user_id>",
+		Short: "Rotate the S3 credentials of the given user",
+		Long: `Rotate the S3 credentials of the given user.
+
+New credentials are created first. They are either displayed, or written to a file using --write-file.
+The previous credentials are then revoked, optionally after a confirmation (--wait-confirmation) or a
+grace period (--grace-period) to let the new credentials be deployed. When they are not written to a file,
+the new credentials are printed on stderr before waiting.
+
+Examples:
+  # Update the "ovh" profile of the AWS credentials file and revoke previous keys after 10 minutes
+  ovhcloud cloud user s3-credentials rotate <user_id> --write-file ~/.aws/credentials --write-format aws-profile --profile ovh --grace-period 10m
+
+  # Write new credentials in an env file and wait for a confirmation before revoking previous keys
+  ovhcloud cloud user s3-credentials rotate <user_id> --write-file s3.env --write-format env --wait-confirmation`,
+		Run:  cloud.RotateUserS3Credentials,
+		Args: cobra.ExactArgs(1),
+	}
+	s3CredentialsRotateCmd.Flags().StringVar(&cloud.UserS3CredentialsRotationParams.OutputFile, "write-file", "", "File to write the new credentials to")
+	s3CredentialsRotateCmd.Flags().StringVar(&cloud.UserS3CredentialsRotationParams.OutputFileFormat, "write-format", "json", "Format of the written file (env, json, aws-profile)")
+	s3CredentialsRotateCmd.Flags().StringVar(&cloud.UserS3CredentialsRotationParams.Profile, "profile", "default", "Profile to update when using the aws-profile format")
+	s3CredentialsRotateCmd.Flags().BoolVar(&cloud.UserS3CredentialsRotationParams.WaitConfirmation, "wait-confirmation", false, "Wait for a confirmation before revoking the previous credentials")
+	s3CredentialsRotateCmd.Flags().DurationVar(&cloud.UserS3CredentialsRotationParams.GracePeriod, "grace-period", 0, "Duration to wait before revoking the previous credentials (e.g. 10m)")
+	s3CredentialsRotateCmd.Flags().BoolVar(&cloud.UserS3CredentialsRotationParams.KeepOld, "keep-old", false, "Do not revoke the previous credentials")
+	s3CredentialsCmd.AddCommand(s3CredentialsRotateCmd)
+
 	cloudCmd.AddCommand(userCmd)
 }
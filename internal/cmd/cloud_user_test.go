@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/maxatome/go-testdeep/td"
+	"github.com/ovh/ovhcloud-cli/internal/cmd"
+)
+
+func (ms *MockSuite) TestCloudUserS3CredentialsRotateCmd(assert, require *td.T) {
+	credentialsFile := filepath.Join(assert.TempDir(), "credentials")
+	require.CmpNoError(os.WriteFile(credentialsFile, []byte(`[default]
+aws_access_key_id = other
+aws_secret_access_key = other-secret
+
+[ovh]
+aws_access_key_id = oldAccess
+aws_secret_access_key = old-secret
+`), 0o600))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/user/12345/s3Credentials",
+		httpmock.NewStringResponder(200, `[{"access": "oldAccess", "userId": "12345", "tenantId": "fakeProjectID"}]`))
+
+	httpmock.RegisterResponder(http.MethodPost, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/user/12345/s3Credentials",
+		httpmock.NewStringResponder(200, `{"access": "newAccess", "secret": "new-secret", "userId": "12345", "tenantId": "fakeProjectID"}`))
+
+	httpmock.RegisterResponder(http.MethodDelete, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/user/12345/s3Credentials/oldAccess",
+		httpmock.NewStringResponder(200, ``))
+
+	out, err := cmd.Execute("cloud", "user", "s3-credentials", "rotate", "12345", "--cloud-project", "fakeProjectID",
+		"--write-file", credentialsFile, "--write-format", "aws-profile", "--profile", "ovh", "--json")
+	require.CmpNoError(err)
+	assert.Cmp(json.RawMessage(out), td.JSON(`{
+		"message": "✅ S3 credentials of user 12345 rotated, new access key: newAccess\nRevoked keys: oldAccess",
+		"details": {
+			"userId": "12345",
+			"access": "newAccess",
+			"revoked": ["oldAccess"]
+		}
+	}`))
+
+	content, err := os.ReadFile(credentialsFile)
+	require.CmpNoError(err)
+	assert.Cmp(string(content), `[default]
+aws_access_key_id = other
+aws_secret_access_key = other-secret
+
+[ovh]
+aws_access_key_id = newAccess
+aws_secret_access_key = new-secret
+`)
+}
+
+func (ms *MockSuite) TestCloudUserS3CredentialsRotateKeepOldCmd(assert, require *td.T) {
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/user/12345/s3Credentials",
+		httpmock.NewStringResponder(200, `[{"access": "oldAccess", "userId": "12345", "tenantId": "fakeProjectID"}]`))
+
+	httpmock.RegisterResponder(http.MethodPost, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/user/12345/s3Credentials",
+		httpmock.NewStringResponder(200, `{"access": "newAccess", "secret": "new-secret", "userId": "12345", "tenantId": "fakeProjectID"}`))
+
+	out, err := cmd.Execute("cloud", "user", "s3-credentials", "rotate", "12345", "--cloud-project", "fakeProjectID", "--keep-old", "--json")
+	require.CmpNoError(err)
+	assert.Cmp(json.RawMessage(out), td.JSON(`{
+		"message": "✅ S3 credentials of user 12345 rotated, new access key: newAccess, secret key: new-secret",
+		"details": {
+			"userId": "12345",
+			"access": "newAccess",
+			"secret": "new-secret",
+			"revoked": []
+		}
+	}`))
+}
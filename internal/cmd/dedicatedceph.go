@@ -22,7 +22,7 @@ func init() {
 		Short:   "List your Dedicated Ceph services",
 		Run:     dedicatedceph.ListDedicatedCeph,
 	}
-	dedicatedcephCmd.AddCommand(withFilterFlag(dedicatedcephListCmd))
+	dedicatedcephCmd.AddCommand(withIAMTagsFlag(withFilterFlag(dedicatedcephListCmd)))
 
 	// Command to get a single DedicatedCeph
 	dedicatedcephCmd.AddCommand(&cobra.Command{
@@ -22,7 +22,7 @@ func init() {
 		Short:   "List your DedicatedCloud services",
 		Run:     dedicatedcloud.ListDedicatedCloud,
 	}
-	dedicatedcloudCmd.AddCommand(withFilterFlag(dedicatedcloudListCmd))
+	dedicatedcloudCmd.AddCommand(withIAMTagsFlag(withFilterFlag(dedicatedcloudListCmd)))
 
 	// Command to get a single DedicatedCloud
 	dedicatedcloudCmd.AddCommand(&cobra.Command{
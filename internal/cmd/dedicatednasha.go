@@ -22,7 +22,7 @@ func init() {
 		Short:   "List your Dedicated NasHA services",
 		Run:     dedicatednasha.ListDedicatedNasHA,
 	}
-	dedicatednashaCmd.AddCommand(withFilterFlag(dedicatednashaListCmd))
+	dedicatednashaCmd.AddCommand(withIAMTagsFlag(withFilterFlag(dedicatednashaListCmd)))
 
 	// Command to get a single DedicatedNasHA
 	dedicatednashaCmd.AddCommand(&cobra.Command{
@@ -22,7 +22,7 @@ func init() {
 		Short:   "List your domain names",
 		Run:     domainname.ListDomainName,
 	}
-	domainnameCmd.AddCommand(withFilterFlag(domainnameListCmd))
+	domainnameCmd.AddCommand(withIAMTagsFlag(withFilterFlag(domainnameListCmd)))
 
 	// Command to get a single DomainName
 	domainnameCmd.AddCommand(&cobra.Command{
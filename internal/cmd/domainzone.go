@@ -23,7 +23,7 @@ func init() {
 		Short:   "List your domain zones",
 		Run:     domainzone.ListDomainZone,
 	}
-	domainzoneCmd.AddCommand(withFilterFlag(domainzoneListCmd))
+	domainzoneCmd.AddCommand(withIAMTagsFlag(withFilterFlag(domainzoneListCmd)))
 
 	// Command to get a single DomainZone
 	domainzoneCmd.AddCommand(&cobra.Command{
@@ -40,6 +40,92 @@ func init() {
 		Run:   domainzone.RefreshZone,
 	})
 
+	domainzoneCmd.AddCommand(&cobra.Command{
+		Use:   "export <zone_name>",
+		Short: "Export the given zone",
+		Long: `Export the given zone in BIND zone file format (RFC 1035).
+
+Use --json or --yaml to export the records of the zone in JSON or YAML format instead.
+
+Examples:
+	ovhcloud domain-zone export example.com > example.com.db
+	ovhcloud domain-zone export example.com --yaml`,
+		Args: cobra.ExactArgs(1),
+		Run:  domainzone.ExportZone,
+	})
+
+	domainzoneImportCmd := &cobra.Command{
+		Use:   "import <zone_name>",
+		Short: "Import records in the given zone from a zone file",
+		Long: `Import records in the given zone from a BIND zone file (RFC 1035).
+
+The records of the file are compared with the current records of the zone, and only the needed changes are applied:
+- with --merge (default), missing records are created and the TTL of existing records is updated,
+  other records of the zone are left untouched;
+- with --replace, the zone is made identical to the file: records are also updated in place and
+  records that are not defined in the file are deleted.
+
+SOA records and the NS records of the zone apex are ignored, as they are managed by OVHcloud.
+The zone is refreshed once the changes are applied.
+
+Examples:
+	# Preview the changes
+	ovhcloud domain-zone import example.com --file example.com.db --replace --dry-run
+
+	# Add the records of the file to the zone
+	ovhcloud domain-zone import example.com --file example.com.db`,
+		Args: cobra.ExactArgs(1),
+		Run:  domainzone.ImportZone,
+	}
+	domainzoneImportCmd.Flags().StringVar(&domainzone.ZoneImportParams.File, "file", "", "Path of the zone file to import")
+	domainzoneImportCmd.Flags().BoolVar(&domainzone.ZoneImportParams.Replace, "replace", false, "Make the zone identical to the file, deleting records that are not defined in it")
+	domainzoneImportCmd.Flags().BoolVar(&domainzone.ZoneImportParams.Merge, "merge", false, "Only add the records of the file to the zone (default)")
+	domainzoneImportCmd.Flags().BoolVar(&domainzone.ZoneImportParams.DryRun, "dry-run", false, "Only display the changes, without applying them")
+	domainzoneImportCmd.MarkFlagRequired("file")
+	domainzoneImportCmd.MarkFlagsMutuallyExclusive("replace", "merge")
+	domainzoneCmd.AddCommand(domainzoneImportCmd)
+
+	domainzoneSyncCmd := &cobra.Command{
+		Use:   "sync <zone_name>",
+		Short: "Synchronize the records of the given zone with a YAML file",
+		Long: `Synchronize the records of the given zone with a YAML file.
+
+The file lists the records to manage, and optionally the name of their owner (default: ovhcloud-cli):
+
+	owner: my-team
+	records:
+	  - subDomain: www
+	    fieldType: CNAME
+	    target: example.com.
+	  - subDomain: ""
+	    fieldType: A
+	    target: 1.2.3.4
+	    ttl: 300
+
+Records managed by this command are marked with a TXT record per name and type
+(e.g. _ovhcloud-owner.cname.www), so that only these records are ever modified or deleted:
+- records of the file are created, updated or deleted to match the file;
+- managed records that are no longer in the file are deleted, along with their marker;
+- other records of the zone are left untouched.
+
+Existing records that are not managed yet are only taken over when --adopt is given.
+Records are created before the old ones are deleted, and the zone is refreshed once at the end.
+
+Examples:
+	# Preview the changes
+	ovhcloud domain-zone sync example.com --file records.yaml --dry-run
+
+	# Apply the changes, taking over existing records defined in the file
+	ovhcloud domain-zone sync example.com --file records.yaml --adopt`,
+		Args: cobra.ExactArgs(1),
+		Run:  domainzone.SyncZone,
+	}
+	domainzoneSyncCmd.Flags().StringVar(&domainzone.ZoneSyncParams.File, "file", "", "Path of the YAML file listing the records")
+	domainzoneSyncCmd.Flags().BoolVar(&domainzone.ZoneSyncParams.DryRun, "dry-run", false, "Only display the changes, without applying them")
+	domainzoneSyncCmd.Flags().BoolVar(&domainzone.ZoneSyncParams.Adopt, "adopt", false, "Take over existing records that are not managed yet")
+	domainzoneSyncCmd.MarkFlagRequired("file")
+	domainzoneCmd.AddCommand(domainzoneSyncCmd)
+
 	domainZoneRecordCmd := &cobra.Command{
 		Use:   "record",
 		Short: "Retrieve information and manage your DNS records within a zone",
@@ -6,6 +6,9 @@ package cmd_test
 
 import (
 	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
 
 	"github.com/jarcoal/httpmock"
 	"github.com/maxatome/go-testdeep/td"
@@ -137,3 +140,211 @@ func (ms *MockSuite) TestDomainZoneDeleteRecord(assert, require *td.T) {
 	require.CmpNoError(err)
 	assert.String(out, `✅ record 1 deleted successfully from example.com`)
 }
+
+func (ms *MockSuite) TestDomainZoneExportCmd(assert, require *td.T) {
+	httpmock.RegisterResponder("GET", "https://eu.api.ovh.com/v1/domain/zone/example.com/export",
+		httpmock.NewStringResponder(200, `"$TTL 3600\n@\tIN SOA dns1.ovh.net. tech.ovh.net. (2025010101 86400 3600 3600000 300)\n\tIN A 1.2.3.4\n"`).Once())
+
+	out, err := cmd.Execute("domain-zone", "export", "example.com")
+
+	require.CmpNoError(err)
+	assert.String(out, "$TTL 3600\n@\tIN SOA dns1.ovh.net. tech.ovh.net. (2025010101 86400 3600 3600000 300)\n\tIN A 1.2.3.4\n")
+}
+
+const testZoneFile = `$ORIGIN example.com.
+$TTL 1h
+@   IN SOA dns1.ovh.net. tech.ovh.net. (
+        2025010101 ; serial
+        86400 3600 3600000 300 )
+    IN NS dns1.ovh.net.
+    IN A 1.2.3.4
+    IN MX 10 mail
+    IN TXT "v=spf1 include:mx.ovh.com ~all"
+www IN CNAME @
+mail IN A 10.0.0.1
+api 300 IN A 1.1.1.1 ; shorter TTL
+`
+
+func (ms *MockSuite) TestDomainZoneImportReplaceDryRunCmd(assert, require *td.T) {
+	httpmock.RegisterResponder("GET", "https://eu.api.ovh.com/v1/domain/zone/example.com/record",
+		httpmock.NewStringResponder(200, `[1, 2, 3, 4, 5, 6, 7]`))
+
+	records := []string{
+		`{"id": 1, "subDomain": "", "fieldType": "A", "target": "1.2.3.4", "ttl": 3600}`,
+		`{"id": 2, "subDomain": "www", "fieldType": "CNAME", "target": "example.com.", "ttl": 3600}`,
+		`{"id": 3, "subDomain": "", "fieldType": "TXT", "target": "\"v=spf1 include:mx.ovh.com ~all\"", "ttl": 3600}`,
+		`{"id": 4, "subDomain": "old", "fieldType": "A", "target": "5.6.7.8", "ttl": 3600}`,
+		`{"id": 5, "subDomain": "", "fieldType": "NS", "target": "dns1.ovh.net.", "ttl": 3600}`,
+		`{"id": 6, "subDomain": "mail", "fieldType": "A", "target": "9.9.9.9", "ttl": 3600}`,
+		`{"id": 7, "subDomain": "api", "fieldType": "A", "target": "1.1.1.1", "ttl": 3600}`,
+	}
+	for i, record := range records {
+		httpmock.RegisterResponder("GET", fmt.Sprintf("https://eu.api.ovh.com/v1/domain/zone/example.com/record/%d", i+1),
+			httpmock.NewStringResponder(200, record))
+	}
+
+	zoneFile := filepath.Join(assert.TempDir(), "example.com.db")
+	require.CmpNoError(os.WriteFile(zoneFile, []byte(testZoneFile), 0o644))
+
+	out, err := cmd.Execute("domain-zone", "import", "example.com", "--file", zoneFile, "--replace", "--dry-run")
+
+	require.CmpNoError(err)
+	assert.String(out, `+ @ 3600 MX 10 mail.example.com.
+~ api 3600 A 1.1.1.1 -> api 300 A 1.1.1.1
+~ mail 3600 A 9.9.9.9 -> mail 3600 A 10.0.0.1
+- old 3600 A 5.6.7.8
+Dry run: 1 record(s) would be created, 2 updated and 1 deleted`)
+}
+
+func (ms *MockSuite) TestDomainZoneImportMergeCmd(assert, require *td.T) {
+	httpmock.RegisterResponder("GET", "https://eu.api.ovh.com/v1/domain/zone/example.com/record",
+		httpmock.NewStringResponder(200, `[1, 2, 3, 4, 5, 6, 7]`))
+
+	records := []string{
+		`{"id": 1, "subDomain": "", "fieldType": "A", "target": "1.2.3.4", "ttl": 3600}`,
+		`{"id": 2, "subDomain": "www", "fieldType": "CNAME", "target": "example.com.", "ttl": 3600}`,
+		`{"id": 3, "subDomain": "", "fieldType": "TXT", "target": "\"v=spf1 include:mx.ovh.com ~all\"", "ttl": 3600}`,
+		`{"id": 4, "subDomain": "old", "fieldType": "A", "target": "5.6.7.8", "ttl": 3600}`,
+		`{"id": 5, "subDomain": "", "fieldType": "NS", "target": "dns1.ovh.net.", "ttl": 3600}`,
+		`{"id": 6, "subDomain": "mail", "fieldType": "A", "target": "9.9.9.9", "ttl": 3600}`,
+		`{"id": 7, "subDomain": "api", "fieldType": "A", "target": "1.1.1.1", "ttl": 3600}`,
+	}
+	for i, record := range records {
+		httpmock.RegisterResponder("GET", fmt.Sprintf("https://eu.api.ovh.com/v1/domain/zone/example.com/record/%d", i+1),
+			httpmock.NewStringResponder(200, record))
+	}
+
+	zoneFile := filepath.Join(assert.TempDir(), "example.com.db")
+	require.CmpNoError(os.WriteFile(zoneFile, []byte(testZoneFile), 0o644))
+
+	httpmock.RegisterMatcherResponder("PUT", "https://eu.api.ovh.com/v1/domain/zone/example.com/record/7",
+		tdhttpmock.JSONBody(td.JSON(`{"subDomain": "api", "target": "1.1.1.1", "ttl": 300}`)),
+		httpmock.NewStringResponder(200, `null`).Once())
+
+	httpmock.RegisterMatcherResponder("POST", "https://eu.api.ovh.com/v1/domain/zone/example.com/record",
+		tdhttpmock.JSONBody(td.JSON(`{"fieldType": "A", "subDomain": "mail", "target": "10.0.0.1", "ttl": 3600}`)),
+		httpmock.NewStringResponder(200, `{"id": 8}`).Once())
+
+	httpmock.RegisterMatcherResponder("POST", "https://eu.api.ovh.com/v1/domain/zone/example.com/record",
+		tdhttpmock.JSONBody(td.JSON(`{"fieldType": "MX", "subDomain": "", "target": "10 mail.example.com.", "ttl": 3600}`)),
+		httpmock.NewStringResponder(200, `{"id": 9}`).Once())
+
+	httpmock.RegisterResponder("POST", "https://eu.api.ovh.com/v1/domain/zone/example.com/refresh",
+		httpmock.NewStringResponder(200, `null`).Once())
+
+	out, err := cmd.Execute("domain-zone", "import", "example.com", "--file", zoneFile)
+
+	require.CmpNoError(err)
+	assert.String(out, `+ mail 3600 A 10.0.0.1
++ @ 3600 MX 10 mail.example.com.
+~ api 3600 A 1.1.1.1 -> api 300 A 1.1.1.1
+✅ Zone example.com imported: 2 record(s) created, 1 updated and 0 deleted, zone refreshed`)
+}
+
+const testZoneSyncFile = `owner: my-team
+records:
+  - subDomain: www
+    fieldType: CNAME
+    target: web.example.net.
+  - subDomain: api
+    fieldType: A
+    target: 1.1.1.1
+    ttl: 300
+  - subDomain: app
+    fieldType: A
+    target: 2.2.2.2
+`
+
+func (ms *MockSuite) TestDomainZoneSyncDryRunCmd(assert, require *td.T) {
+	httpmock.RegisterResponder("GET", "https://eu.api.ovh.com/v1/domain/zone/example.com/record",
+		httpmock.NewStringResponder(200, `[1, 2, 3, 4, 5, 6]`))
+
+	records := []string{
+		`{"id": 1, "subDomain": "www", "fieldType": "CNAME", "target": "example.com.", "ttl": 3600}`,
+		`{"id": 2, "subDomain": "_ovhcloud-owner.cname.www", "fieldType": "TXT", "target": "\"heritage=ovhcloud-cli,owner=my-team\"", "ttl": 3600}`,
+		`{"id": 3, "subDomain": "old", "fieldType": "A", "target": "5.6.7.8", "ttl": 3600}`,
+		`{"id": 4, "subDomain": "_ovhcloud-owner.a.old", "fieldType": "TXT", "target": "\"heritage=ovhcloud-cli,owner=my-team\"", "ttl": 3600}`,
+		`{"id": 5, "subDomain": "mail", "fieldType": "A", "target": "9.9.9.9", "ttl": 3600}`,
+		`{"id": 6, "subDomain": "api", "fieldType": "A", "target": "1.1.1.1", "ttl": 3600}`,
+	}
+	for i, record := range records {
+		httpmock.RegisterResponder("GET", fmt.Sprintf("https://eu.api.ovh.com/v1/domain/zone/example.com/record/%d", i+1),
+			httpmock.NewStringResponder(200, record))
+	}
+
+	syncFile := filepath.Join(assert.TempDir(), "records.yaml")
+	require.CmpNoError(os.WriteFile(syncFile, []byte(testZoneSyncFile), 0o644))
+
+	out, err := cmd.Execute("domain-zone", "sync", "example.com", "--file", syncFile, "--dry-run", "--adopt")
+
+	require.CmpNoError(err)
+	assert.String(out, `+ _ovhcloud-owner.a.api 300 TXT "heritage=ovhcloud-cli,owner=my-team"
++ app 3600 A 2.2.2.2
++ _ovhcloud-owner.a.app 3600 TXT "heritage=ovhcloud-cli,owner=my-team"
+~ www 3600 CNAME example.com. -> www 3600 CNAME web.example.net.
+~ api 3600 A 1.1.1.1 -> api 300 A 1.1.1.1
+- old 3600 A 5.6.7.8
+- _ovhcloud-owner.a.old 3600 TXT "heritage=ovhcloud-cli,owner=my-team"
+Dry run: 3 record(s) would be created, 2 updated and 2 deleted`)
+}
+
+func (ms *MockSuite) TestDomainZoneSyncCmd(assert, require *td.T) {
+	httpmock.RegisterResponder("GET", "https://eu.api.ovh.com/v1/domain/zone/example.com/record",
+		httpmock.NewStringResponder(200, `[1, 2, 3, 4, 5, 6]`))
+
+	records := []string{
+		`{"id": 1, "subDomain": "www", "fieldType": "CNAME", "target": "example.com.", "ttl": 3600}`,
+		`{"id": 2, "subDomain": "_ovhcloud-owner.cname.www", "fieldType": "TXT", "target": "\"heritage=ovhcloud-cli,owner=my-team\"", "ttl": 3600}`,
+		`{"id": 3, "subDomain": "old", "fieldType": "A", "target": "5.6.7.8", "ttl": 3600}`,
+		`{"id": 4, "subDomain": "_ovhcloud-owner.a.old", "fieldType": "TXT", "target": "\"heritage=ovhcloud-cli,owner=my-team\"", "ttl": 3600}`,
+		`{"id": 5, "subDomain": "mail", "fieldType": "A", "target": "9.9.9.9", "ttl": 3600}`,
+		`{"id": 6, "subDomain": "api", "fieldType": "A", "target": "1.1.1.1", "ttl": 3600}`,
+	}
+	for i, record := range records {
+		httpmock.RegisterResponder("GET", fmt.Sprintf("https://eu.api.ovh.com/v1/domain/zone/example.com/record/%d", i+1),
+			httpmock.NewStringResponder(200, record))
+	}
+
+	syncFile := filepath.Join(assert.TempDir(), "records.yaml")
+	require.CmpNoError(os.WriteFile(syncFile, []byte(`owner: my-team
+records:
+  - subDomain: www
+    fieldType: CNAME
+    target: web.example.net.
+  - subDomain: app
+    fieldType: A
+    target: 2.2.2.2
+`), 0o644))
+
+	httpmock.RegisterMatcherResponder("POST", "https://eu.api.ovh.com/v1/domain/zone/example.com/record",
+		tdhttpmock.JSONBody(td.JSON(`{"fieldType": "A", "subDomain": "app", "target": "2.2.2.2", "ttl": 3600}`)),
+		httpmock.NewStringResponder(200, `{"id": 7}`).Once())
+
+	httpmock.RegisterMatcherResponder("POST", "https://eu.api.ovh.com/v1/domain/zone/example.com/record",
+		tdhttpmock.JSONBody(td.JSON(`{"fieldType": "TXT", "subDomain": "_ovhcloud-owner.a.app", "target": "\"heritage=ovhcloud-cli,owner=my-team\"", "ttl": 3600}`)),
+		httpmock.NewStringResponder(200, `{"id": 8}`).Once())
+
+	httpmock.RegisterMatcherResponder("PUT", "https://eu.api.ovh.com/v1/domain/zone/example.com/record/1",
+		tdhttpmock.JSONBody(td.JSON(`{"subDomain": "www", "target": "web.example.net.", "ttl": 3600}`)),
+		httpmock.NewStringResponder(200, `null`).Once())
+
+	httpmock.RegisterResponder("DELETE", "https://eu.api.ovh.com/v1/domain/zone/example.com/record/3",
+		httpmock.NewStringResponder(200, `null`).Once())
+
+	httpmock.RegisterResponder("DELETE", "https://eu.api.ovh.com/v1/domain/zone/example.com/record/4",
+		httpmock.NewStringResponder(200, `null`).Once())
+
+	httpmock.RegisterResponder("POST", "https://eu.api.ovh.com/v1/domain/zone/example.com/refresh",
+		httpmock.NewStringResponder(200, `null`).Once())
+
+	out, err := cmd.Execute("domain-zone", "sync", "example.com", "--file", syncFile)
+
+	require.CmpNoError(err)
+	assert.String(out, `+ app 3600 A 2.2.2.2
++ _ovhcloud-owner.a.app 3600 TXT "heritage=ovhcloud-cli,owner=my-team"
+~ www 3600 CNAME example.com. -> www 3600 CNAME web.example.net.
+- old 3600 A 5.6.7.8
+- _ovhcloud-owner.a.old 3600 TXT "heritage=ovhcloud-cli,owner=my-team"
+✅ Zone example.com synced: 2 record(s) created, 1 updated and 2 deleted, zone refreshed`)
+	assert.Cmp(httpmock.GetCallCountInfo()["POST https://eu.api.ovh.com/v1/domain/zone/example.com/refresh"], 1)
+}
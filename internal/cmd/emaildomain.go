@@ -23,7 +23,7 @@ func init() {
 		Short:   "List your Email Domain services",
 		Run:     emaildomain.ListEmailDomain,
 	}
-	emaildomainCmd.AddCommand(withFilterFlag(emaildomainListCmd))
+	emaildomainCmd.AddCommand(withIAMTagsFlag(withFilterFlag(emaildomainListCmd)))
 
 	// Command to get a single EmailDomain
 	emaildomainCmd.AddCommand(&cobra.Command{
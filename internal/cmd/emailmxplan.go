@@ -22,7 +22,7 @@ func init() {
 		Short:   "List your Email MXPlan services",
 		Run:     emailmxplan.ListEmailMXPlan,
 	}
-	emailmxplanCmd.AddCommand(withFilterFlag(emailmxplanListCmd))
+	emailmxplanCmd.AddCommand(withIAMTagsFlag(withFilterFlag(emailmxplanListCmd)))
 
 	// Command to get a single EmailMXPlan
 	emailmxplanCmd.AddCommand(&cobra.Command{
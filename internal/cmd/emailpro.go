@@ -22,7 +22,7 @@ func init() {
 		Short:   "List your EmailPro services",
 		Run:     emailpro.ListEmailPro,
 	}
-	emailproCmd.AddCommand(withFilterFlag(emailproListCmd))
+	emailproCmd.AddCommand(withIAMTagsFlag(withFilterFlag(emailproListCmd)))
 
 	// Command to get a single EmailPro
 	emailproCmd.AddCommand(&cobra.Command{
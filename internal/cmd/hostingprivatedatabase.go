@@ -22,7 +22,7 @@ func init() {
 		Short:   "List your HostingPrivateDatabase services",
 		Run:     hostingprivatedatabase.ListHostingPrivateDatabase,
 	}
-	hostingprivatedatabaseCmd.AddCommand(withFilterFlag(hostingprivatedatabaseListCmd))
+	hostingprivatedatabaseCmd.AddCommand(withIAMTagsFlag(withFilterFlag(hostingprivatedatabaseListCmd)))
 
 	// Command to get a single HostingPrivateDatabase
 	hostingprivatedatabaseCmd.AddCommand(&cobra.Command{
@@ -79,6 +79,78 @@ func init() {
 		Args:  cobra.ExactArgs(1),
 	})
 
+	iamPolicyCheckCmd := &cobra.Command{
+		Use:   "check",
+		Short: "Check whether an identity is allowed to perform an action on a resource",
+		Long: `Check whether an identity is allowed to perform an action on a resource, according to the IAM policies of the account.
+
+All the policies are evaluated locally: the permissions groups and resource groups they reference are resolved,
+and actions and URNs are matched using wildcards ("*" matches any sequence of characters). Denied actions take
+precedence over allowed ones, and excepted actions remove actions from the ones allowed by a policy.
+When the identity is a local user, the policies applying to its group are also evaluated.
+
+The output explains which policies allowed or denied the action.
+
+Example:
+	ovhcloud iam policy check --identity urn:v1:eu:identity:user:aa1-ovh/ops --action vps:apiovh:reboot --resource urn:v1:eu:resource:vps:vps-1234.vps.ovh.net`,
+		Run:  iam.CheckIAMPolicies,
+		Args: cobra.NoArgs,
+	}
+	iamPolicyCheckCmd.Flags().StringVar(&iam.IAMPolicyCheckParams.Identity, "identity", "", "URN of the identity performing the action")
+	iamPolicyCheckCmd.Flags().StringVar(&iam.IAMPolicyCheckParams.Action, "action", "", "Action to check (e.g. vps:apiovh:reboot)")
+	iamPolicyCheckCmd.Flags().StringVar(&iam.IAMPolicyCheckParams.Resource, "resource", "", "URN of the resource on which the action is performed")
+	iamPolicyCheckCmd.MarkFlagRequired("identity")
+	iamPolicyCheckCmd.MarkFlagRequired("action")
+	iamPolicyCheckCmd.MarkFlagRequired("resource")
+	iamPolicyCmd.AddCommand(iamPolicyCheckCmd)
+
+	iamPolicyExportCmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export IAM policies, permissions groups and resource groups as YAML files",
+		Long: `Export the IAM policies, permissions groups and resource groups of the account as YAML files.
+
+Each object is written in its own file, in the policies/, permissions-groups/ and resource-groups/ subdirectories
+of the given directory. Policies reference permissions groups and resource groups by name, and the display names
+of resources are added when available. Objects managed by OVHcloud are not exported. YAML files left in these
+subdirectories by a previous export are removed.
+
+The exported files can be edited and applied back using "ovhcloud iam policy import".
+
+Example:
+	ovhcloud iam policy export --dir policies/`,
+		Run:  iam.ExportIAMPolicies,
+		Args: cobra.NoArgs,
+	}
+	iamPolicyExportCmd.Flags().StringVar(&iam.IAMPolicyCodeParams.Dir, "dir", "", "Directory in which to write the YAML files")
+	iamPolicyExportCmd.MarkFlagRequired("dir")
+	iamPolicyCmd.AddCommand(iamPolicyExportCmd)
+
+	iamPolicyImportCmd := &cobra.Command{
+		Use:   "import",
+		Short: "Reconcile IAM policies, permissions groups and resource groups with YAML files",
+		Long: `Reconcile the IAM policies, permissions groups and resource groups of the account with the YAML files
+of the given directory, as written by "ovhcloud iam policy export".
+
+Objects are matched by name: missing objects are created and objects that differ are updated. Objects that are
+not defined in the files are only deleted when --prune is given, and --prune is refused when the directory
+does not contain any definition. The changes are displayed as a diff, and
+nothing is applied when --dry-run is given.
+
+Examples:
+	# Preview the changes
+	ovhcloud iam policy import --dir policies/ --dry-run
+
+	# Apply the changes, deleting the objects not defined in the files
+	ovhcloud iam policy import --dir policies/ --prune`,
+		Run:  iam.ImportIAMPolicies,
+		Args: cobra.NoArgs,
+	}
+	iamPolicyImportCmd.Flags().StringVar(&iam.IAMPolicyCodeParams.Dir, "dir", "", "Directory containing the YAML files")
+	iamPolicyImportCmd.Flags().BoolVar(&iam.IAMPolicyCodeParams.Prune, "prune", false, "Delete the objects that are not defined in the files")
+	iamPolicyImportCmd.Flags().BoolVar(&iam.IAMPolicyCodeParams.DryRun, "dry-run", false, "Only display the changes, without applying them")
+	iamPolicyImportCmd.MarkFlagRequired("dir")
+	iamPolicyCmd.AddCommand(iamPolicyImportCmd)
+
 	iamPermissionsGroupCmd := &cobra.Command{
 		Use:   "permissions-group",
 		Short: "Manage IAM permissions groups",
@@ -119,12 +191,14 @@ func init() {
 	}
 	iamCmd.AddCommand(iamResourceCmd)
 
-	iamResourceCmd.AddCommand(withFilterFlag(&cobra.Command{
+	iamResourceListCmd := withIAMTagsFlag(withFilterFlag(&cobra.Command{
 		Use:     "list",
 		Aliases: []string{"ls"},
 		Short:   "List IAM resources",
 		Run:     iam.ListIAMResources,
 	}))
+	iamResourceListCmd.Flags().StringToStringVar(&iam.IAMResourceTagFilters, "tag", nil, "Only list resources having the given tags (e.g. --tag env=prod)")
+	iamResourceCmd.AddCommand(iamResourceListCmd)
 
 	iamResourceCmd.AddCommand(&cobra.Command{
 		Use:   "get <resource_urn>",
@@ -143,6 +217,44 @@ func init() {
 	addInteractiveEditorFlag(iamResourceEditCmd)
 	iamResourceCmd.AddCommand(iamResourceEditCmd)
 
+	iamResourceTagCmd := &cobra.Command{
+		Use:   "tag",
+		Short: "Manage tags of IAM resources",
+	}
+	iamResourceCmd.AddCommand(iamResourceTagCmd)
+
+	iamResourceTagCmd.AddCommand(withFilterFlag(&cobra.Command{
+		Use:   "add [<resource_urn>] <key=value>...",
+		Short: "Add tags to IAM resources",
+		Long: `Add tags to an IAM resource, or to all the IAM resources matching the given filters.
+Tags that are already set with the same value are left untouched.
+
+Examples:
+	# Tag a single resource
+	ovhcloud iam resource tag add urn:v1:eu:resource:vps:vps-1234.vps.ovh.net env=prod team=web
+
+	# Tag all the VPS of the account
+	ovhcloud iam resource tag add --filter 'type=="vps"' env=prod`,
+		Run:  iam.AddIAMResourceTags,
+		Args: cobra.MinimumNArgs(1),
+	}))
+
+	iamResourceTagCmd.AddCommand(withFilterFlag(&cobra.Command{
+		Use:   "remove [<resource_urn>] <key[=value]>...",
+		Short: "Remove tags from IAM resources",
+		Long: `Remove tags from an IAM resource, or from all the IAM resources matching the given filters.
+When a value is given, the tag is only removed from the resources on which it has this value.
+
+Examples:
+	# Remove tags from a single resource
+	ovhcloud iam resource tag remove urn:v1:eu:resource:vps:vps-1234.vps.ovh.net env team
+
+	# Remove tag env=staging from all the VPS having it
+	ovhcloud iam resource tag remove --filter 'type=="vps"' env=staging`,
+		Run:  iam.RemoveIAMResourceTags,
+		Args: cobra.MinimumNArgs(1),
+	}))
+
 	iamResourceGroupCmd := &cobra.Command{
 		Use:   "resource-group",
 		Short: "Manage IAM resource groups",
@@ -205,6 +317,26 @@ func init() {
 		Args:  cobra.ExactArgs(1),
 	})
 
+	iamUserAuditCmd := withFilterFlag(&cobra.Command{
+		Use:   "audit",
+		Short: "Report the activity, MFA state and tokens of all IAM users",
+		Long: `Report, for each IAM user, its group, status, last login, the MFA method used at last login
+and its tokens along with their expiration dates.
+
+Last logins are read from the audit logs of the account. Users without any login for more than
+--stale-days days (or created before that and never logged in), users that logged in without MFA
+and tokens that never expire are reported in the "warnings" column.
+
+Examples:
+	ovhcloud iam user audit
+	ovhcloud iam user audit --stale-days 30 --json
+	ovhcloud iam user audit --filter 'stale'`,
+		Run:  iam.AuditUsers,
+		Args: cobra.NoArgs,
+	})
+	iamUserAuditCmd.Flags().IntVar(&iam.IAMUserAuditStaleDays, "stale-days", 90, "Number of days without login after which a user is reported as stale")
+	iamUserCmd.AddCommand(iamUserAuditCmd)
+
 	tokenCmd := &cobra.Command{
 		Use:   "token",
 		Short: "Manage IAM user tokens",
@@ -244,6 +376,21 @@ func init() {
 		Args:  cobra.ExactArgs(2),
 	})
 
+	tokenRotateCmd := &cobra.Command{
+		Use:   "rotate <user_login> <token_name>",
+		Short: "Replace a token of an IAM user with a new one",
+		Long: `Create a new token with the same description and validity duration as the given token, then delete the given token.
+
+By default, the new token is named after the rotated one with a timestamp suffix.
+
+Example:
+	ovhcloud iam user token rotate my_user ci-token`,
+		Run:  iam.RotateUserToken,
+		Args: cobra.ExactArgs(2),
+	}
+	tokenRotateCmd.Flags().StringVar(&iam.IAMTokenRotateName, "name", "", "Name of the new token")
+	tokenCmd.AddCommand(tokenRotateCmd)
+
 	rootCmd.AddCommand(iamCmd)
 }
 
@@ -5,7 +5,10 @@
 package cmd_test
 
 import (
+	"encoding/json"
 	"net/http"
+	"os"
+	"path/filepath"
 
 	"github.com/jarcoal/httpmock"
 	"github.com/maxatome/go-testdeep/td"
@@ -110,3 +113,674 @@ func (ms *MockSuite) TestIAMPolicyCreateCmd(assert, require *td.T) {
 	require.CmpNoError(err)
 	assert.String(out, `✅ IAM policy policy-1234 created successfully`)
 }
+
+func (ms *MockSuite) TestIAMPolicyCheckAllowedCmd(assert, require *td.T) {
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/me/identity/user/ops",
+		httpmock.NewStringResponder(200, `{"login": "ops", "group": "operators"}`),
+	)
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v2/iam/policy",
+		httpmock.NewStringResponder(200, `[
+			{
+				"id": "policy-ops",
+				"name": "operators",
+				"identities": ["urn:v1:eu:identity:group:aa1-ovh/operators"],
+				"resources": [{"urn": "urn:v1:eu:resourceGroup:rg-1234"}],
+				"permissions": {"allow": [{"action": "vps:apiovh:*"}], "except": [{"action": "vps:apiovh:delete"}]}
+			},
+			{
+				"id": "policy-readonly",
+				"name": "readonly",
+				"identities": ["urn:v1:eu:identity:user:aa1-ovh/ops"],
+				"resources": [{"urn": "urn:v1:eu:resource:vps:*"}],
+				"permissions": {},
+				"permissionsGroups": [{"urn": "urn:v2:eu:permissionsGroup:aa1-ovh:readonly"}]
+			},
+			{
+				"id": "policy-other",
+				"name": "other",
+				"identities": ["urn:v1:eu:identity:user:aa1-ovh/someone-else"],
+				"resources": [{"urn": "urn:v1:eu:resource:vps:*"}],
+				"permissions": {"deny": [{"action": "*"}]}
+			}
+		]`),
+	)
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v2/iam/resourceGroup/rg-1234?details=true",
+		httpmock.NewStringResponder(200, `{
+			"id": "rg-1234",
+			"resources": [{"urn": "urn:v1:eu:resource:vps:vps-1234.vps.ovh.net"}]
+		}`),
+	)
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v2/iam/permissionsGroup/urn:v2:eu:permissionsGroup:aa1-ovh:readonly",
+		httpmock.NewStringResponder(200, `{
+			"name": "readonly",
+			"permissions": {"allow": [{"action": "*:apiovh:get"}], "deny": [{"action": "vps:apiovh:reboot"}]}
+		}`),
+	)
+
+	out, err := cmd.Execute("iam", "policy", "check",
+		"--identity", "urn:v1:eu:identity:user:aa1-ovh/ops",
+		"--action", "vps:apiovh:ips/get",
+		"--resource", "urn:v1:eu:resource:vps:vps-1234.vps.ovh.net",
+	)
+	require.CmpNoError(err)
+	assert.String(out, `✅ urn:v1:eu:identity:user:aa1-ovh/ops is allowed to perform vps:apiovh:ips/get on urn:v1:eu:resource:vps:vps-1234.vps.ovh.net
+  - allowed by policy "operators" (policy-ops) through action "vps:apiovh:*" of policy`)
+}
+
+func (ms *MockSuite) TestIAMPolicyCheckDeniedCmd(assert, require *td.T) {
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/me/identity/user/ops",
+		httpmock.NewStringResponder(200, `{"login": "ops", "group": "operators"}`),
+	)
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v2/iam/policy",
+		httpmock.NewStringResponder(200, `[
+			{
+				"id": "policy-ops",
+				"name": "operators",
+				"identities": ["urn:v1:eu:identity:group:aa1-ovh/operators"],
+				"resources": [{"urn": "urn:v1:eu:resourceGroup:rg-1234"}],
+				"permissions": {"allow": [{"action": "vps:apiovh:*"}], "except": [{"action": "vps:apiovh:delete"}]}
+			},
+			{
+				"id": "policy-readonly",
+				"name": "readonly",
+				"identities": ["urn:v1:eu:identity:user:aa1-ovh/ops"],
+				"resources": [{"urn": "urn:v1:eu:resource:vps:*"}],
+				"permissions": {},
+				"permissionsGroups": [{"urn": "urn:v2:eu:permissionsGroup:aa1-ovh:readonly"}]
+			},
+			{
+				"id": "policy-other",
+				"name": "other",
+				"identities": ["urn:v1:eu:identity:user:aa1-ovh/someone-else"],
+				"resources": [{"urn": "urn:v1:eu:resource:vps:*"}],
+				"permissions": {"deny": [{"action": "*"}]}
+			}
+		]`),
+	)
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v2/iam/resourceGroup/rg-1234?details=true",
+		httpmock.NewStringResponder(200, `{
+			"id": "rg-1234",
+			"resources": [{"urn": "urn:v1:eu:resource:vps:vps-1234.vps.ovh.net"}]
+		}`),
+	)
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v2/iam/permissionsGroup/urn:v2:eu:permissionsGroup:aa1-ovh:readonly",
+		httpmock.NewStringResponder(200, `{
+			"name": "readonly",
+			"permissions": {"allow": [{"action": "*:apiovh:get"}], "deny": [{"action": "vps:apiovh:reboot"}]}
+		}`),
+	)
+
+	out, err := cmd.Execute("iam", "policy", "check",
+		"--identity", "urn:v1:eu:identity:user:aa1-ovh/ops",
+		"--action", "vps:apiovh:reboot",
+		"--resource", "urn:v1:eu:resource:vps:vps-1234.vps.ovh.net",
+		"--json",
+	)
+	require.CmpNoError(err)
+	assert.Cmp(json.RawMessage(out), td.JSON(`{
+		"message": Contains("is not allowed"),
+		"details": {
+			"identities": ["urn:v1:eu:identity:user:aa1-ovh/ops", "urn:v1:eu:identity:group:aa1-ovh/operators"],
+			"action": "vps:apiovh:reboot",
+			"resource": "urn:v1:eu:resource:vps:vps-1234.vps.ovh.net",
+			"decision": "deny",
+			"matches": [
+				{
+					"policyId": "policy-ops",
+					"policyName": "operators",
+					"effect": "allow",
+					"action": "vps:apiovh:*",
+					"source": "policy"
+				},
+				{
+					"policyId": "policy-readonly",
+					"policyName": "readonly",
+					"effect": "deny",
+					"action": "vps:apiovh:reboot",
+					"source": "permissions group urn:v2:eu:permissionsGroup:aa1-ovh:readonly"
+				}
+			]
+		}
+	}`))
+}
+
+func (ms *MockSuite) TestIAMPolicyExportCmd(assert, require *td.T) {
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v2/iam/policy",
+		httpmock.NewStringResponder(200, `[
+			{
+				"id": "policy-ops",
+				"name": "ops",
+				"description": "Operators",
+				"identities": ["urn:v1:eu:identity:group:aa1-ovh/operators"],
+				"resources": [{"urn": "urn:v1:eu:resourceGroup:rg-1"}, {"urn": "urn:v1:eu:resource:domain:example.com"}],
+				"permissions": {"allow": [{"action": "vps:apiovh:reboot"}, {"action": "vps:apiovh:get"}]},
+				"permissionsGroups": [{"urn": "urn:v2:eu:permissionsGroup:aa1-ovh:pg-1"}, {"urn": "urn:v2:eu:permissionsGroup:ovh:admin"}]
+			},
+			{
+				"id": "policy-legacy",
+				"name": "legacy",
+				"identities": ["urn:v1:eu:identity:user:aa1-ovh/ops"],
+				"resources": [{"urn": "urn:v1:eu:resource:vps:*"}],
+				"permissions": {"allow": [{"action": "*"}]}
+			},
+			{
+				"id": "policy-managed",
+				"name": "ovh-default",
+				"readOnly": true,
+				"identities": ["urn:v1:eu:identity:account:aa1-ovh"],
+				"resources": [{"urn": "urn:v1:eu:resource:account:aa1-ovh"}],
+				"permissions": {"allow": [{"action": "*"}]}
+			}
+		]`),
+	)
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v2/iam/permissionsGroup",
+		httpmock.NewStringResponder(200, `[
+			{
+				"urn": "urn:v2:eu:permissionsGroup:aa1-ovh:pg-1",
+				"name": "vps-readers",
+				"owner": "aa1-ovh",
+				"permissions": {"allow": [{"action": "vps:apiovh:get"}]}
+			},
+			{
+				"urn": "urn:v2:eu:permissionsGroup:ovh:admin",
+				"name": "admin",
+				"owner": "ovh",
+				"readOnly": true,
+				"permissions": {"allow": [{"action": "*"}]}
+			}
+		]`),
+	)
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v2/iam/resourceGroup?details=true",
+		httpmock.NewStringResponder(200, `[
+			{
+				"id": "rg-1",
+				"urn": "urn:v1:eu:resourceGroup:rg-1",
+				"name": "web",
+				"owner": "aa1-ovh",
+				"resources": [{"urn": "urn:v1:eu:resource:vps:vps-1234.vps.ovh.net"}]
+			}
+		]`),
+	)
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v2/iam/resource",
+		httpmock.NewStringResponder(200, `[
+			{"urn": "urn:v1:eu:resource:vps:vps-1234.vps.ovh.net", "displayName": "web-server"},
+			{"urn": "urn:v1:eu:resource:domain:example.com", "displayName": "example.com"}
+		]`),
+	)
+
+	// Files of a previous export must not survive the new one
+	dir := assert.TempDir()
+	require.CmpNoError(os.MkdirAll(filepath.Join(dir, "policies"), 0o755))
+	require.CmpNoError(os.WriteFile(filepath.Join(dir, "policies", "deleted.yaml"), []byte("name: deleted\n"), 0o644))
+
+	out, err := cmd.Execute("iam", "policy", "export", "--dir", dir)
+	require.CmpNoError(err)
+	assert.String(out, "✅ Exported 2 policies, 1 permissions groups and 1 resource groups to "+dir)
+
+	_, err = os.Stat(filepath.Join(dir, "policies", "deleted.yaml"))
+	assert.True(os.IsNotExist(err))
+
+	policy, err := os.ReadFile(filepath.Join(dir, "policies", "ops.yaml"))
+	require.CmpNoError(err)
+	assert.String(string(policy), `allow:
+- vps:apiovh:get
+- vps:apiovh:reboot
+description: Operators
+identities:
+- urn:v1:eu:identity:group:aa1-ovh/operators
+name: ops
+permissionsGroups:
+- urn:v2:eu:permissionsGroup:ovh:admin
+- vps-readers
+resources:
+- displayName: example.com
+  urn: urn:v1:eu:resource:domain:example.com
+- resourceGroup: web
+`)
+
+	group, err := os.ReadFile(filepath.Join(dir, "resource-groups", "web.yaml"))
+	require.CmpNoError(err)
+	assert.String(string(group), `name: web
+resources:
+- displayName: web-server
+  urn: urn:v1:eu:resource:vps:vps-1234.vps.ovh.net
+`)
+
+	assert.Cmp(filepath.Join(dir, "permissions-groups", "vps-readers.yaml"), td.Smuggle(os.Stat, td.Not(td.Nil())))
+	assert.Cmp(filepath.Join(dir, "policies", "ovh-default.yaml"), td.Smuggle(func(path string) bool {
+		_, err := os.Stat(path)
+		return os.IsNotExist(err)
+	}, true))
+}
+
+func (ms *MockSuite) TestIAMPolicyImportDryRunCmd(assert, require *td.T) {
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v2/iam/policy",
+		httpmock.NewStringResponder(200, `[
+			{
+				"id": "policy-ops",
+				"name": "ops",
+				"description": "Operators",
+				"identities": ["urn:v1:eu:identity:group:aa1-ovh/operators"],
+				"resources": [{"urn": "urn:v1:eu:resourceGroup:rg-1"}, {"urn": "urn:v1:eu:resource:domain:example.com"}],
+				"permissions": {"allow": [{"action": "vps:apiovh:reboot"}, {"action": "vps:apiovh:get"}]},
+				"permissionsGroups": [{"urn": "urn:v2:eu:permissionsGroup:aa1-ovh:pg-1"}, {"urn": "urn:v2:eu:permissionsGroup:ovh:admin"}]
+			},
+			{
+				"id": "policy-legacy",
+				"name": "legacy",
+				"identities": ["urn:v1:eu:identity:user:aa1-ovh/ops"],
+				"resources": [{"urn": "urn:v1:eu:resource:vps:*"}],
+				"permissions": {"allow": [{"action": "*"}]}
+			},
+			{
+				"id": "policy-managed",
+				"name": "ovh-default",
+				"readOnly": true,
+				"identities": ["urn:v1:eu:identity:account:aa1-ovh"],
+				"resources": [{"urn": "urn:v1:eu:resource:account:aa1-ovh"}],
+				"permissions": {"allow": [{"action": "*"}]}
+			}
+		]`),
+	)
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v2/iam/permissionsGroup",
+		httpmock.NewStringResponder(200, `[
+			{
+				"urn": "urn:v2:eu:permissionsGroup:aa1-ovh:pg-1",
+				"name": "vps-readers",
+				"owner": "aa1-ovh",
+				"permissions": {"allow": [{"action": "vps:apiovh:get"}]}
+			},
+			{
+				"urn": "urn:v2:eu:permissionsGroup:ovh:admin",
+				"name": "admin",
+				"owner": "ovh",
+				"readOnly": true,
+				"permissions": {"allow": [{"action": "*"}]}
+			}
+		]`),
+	)
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v2/iam/resourceGroup?details=true",
+		httpmock.NewStringResponder(200, `[
+			{
+				"id": "rg-1",
+				"urn": "urn:v1:eu:resourceGroup:rg-1",
+				"name": "web",
+				"owner": "aa1-ovh",
+				"resources": [{"urn": "urn:v1:eu:resource:vps:vps-1234.vps.ovh.net"}]
+			}
+		]`),
+	)
+	dir := require.TempDir()
+
+	files := map[string]string{
+		"policies/ops.yaml": `name: ops
+description: Operators
+identities:
+- urn:v1:eu:identity:group:aa1-ovh/operators
+resources:
+- resourceGroup: web
+- resourceGroup: databases
+- urn: urn:v1:eu:resource:domain:example.com
+  displayName: example.com
+permissionsGroups:
+- vps-readers
+- urn:v2:eu:permissionsGroup:ovh:admin
+allow:
+- vps:apiovh:get
+`,
+		"permissions-groups/vps-readers.yaml": `name: vps-readers
+allow:
+- vps:apiovh:get
+`,
+		"resource-groups/web.yaml": `name: web
+resources:
+- urn: urn:v1:eu:resource:vps:vps-1234.vps.ovh.net
+  displayName: web-server
+`,
+		"resource-groups/databases.yaml": `name: databases
+resources:
+- urn: urn:v1:eu:resource:publicCloudProject:fakeProjectID
+`,
+	}
+	for path, content := range files {
+		require.CmpNoError(os.MkdirAll(filepath.Dir(filepath.Join(dir, path)), 0o755))
+		require.CmpNoError(os.WriteFile(filepath.Join(dir, path), []byte(content), 0o644))
+	}
+
+	out, err := cmd.Execute("iam", "policy", "import", "--dir", dir, "--dry-run")
+	require.CmpNoError(err)
+	assert.String(out, `+ resource group databases
+~ policy ops
+    allow: ["vps:apiovh:get","vps:apiovh:reboot"] -> ["vps:apiovh:get"]
+    resources: [{"urn":"urn:v1:eu:resource:domain:example.com"},{"resourceGroup":"web"}] -> [{"urn":"urn:v1:eu:resource:domain:example.com"},{"resourceGroup":"databases"},{"resourceGroup":"web"}]
+1 object(s) not defined in `+dir+` are kept, use --prune to delete them
+Dry run: 1 object(s) would be created, 1 updated and 0 deleted`)
+}
+
+func (ms *MockSuite) TestIAMPolicyImportCmd(assert, require *td.T) {
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v2/iam/policy",
+		httpmock.NewStringResponder(200, `[
+			{
+				"id": "policy-ops",
+				"name": "ops",
+				"description": "Operators",
+				"identities": ["urn:v1:eu:identity:group:aa1-ovh/operators"],
+				"resources": [{"urn": "urn:v1:eu:resourceGroup:rg-1"}, {"urn": "urn:v1:eu:resource:domain:example.com"}],
+				"permissions": {"allow": [{"action": "vps:apiovh:reboot"}, {"action": "vps:apiovh:get"}]},
+				"permissionsGroups": [{"urn": "urn:v2:eu:permissionsGroup:aa1-ovh:pg-1"}, {"urn": "urn:v2:eu:permissionsGroup:ovh:admin"}]
+			},
+			{
+				"id": "policy-legacy",
+				"name": "legacy",
+				"identities": ["urn:v1:eu:identity:user:aa1-ovh/ops"],
+				"resources": [{"urn": "urn:v1:eu:resource:vps:*"}],
+				"permissions": {"allow": [{"action": "*"}]}
+			},
+			{
+				"id": "policy-managed",
+				"name": "ovh-default",
+				"readOnly": true,
+				"identities": ["urn:v1:eu:identity:account:aa1-ovh"],
+				"resources": [{"urn": "urn:v1:eu:resource:account:aa1-ovh"}],
+				"permissions": {"allow": [{"action": "*"}]}
+			}
+		]`),
+	)
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v2/iam/permissionsGroup",
+		httpmock.NewStringResponder(200, `[
+			{
+				"urn": "urn:v2:eu:permissionsGroup:aa1-ovh:pg-1",
+				"name": "vps-readers",
+				"owner": "aa1-ovh",
+				"permissions": {"allow": [{"action": "vps:apiovh:get"}]}
+			},
+			{
+				"urn": "urn:v2:eu:permissionsGroup:ovh:admin",
+				"name": "admin",
+				"owner": "ovh",
+				"readOnly": true,
+				"permissions": {"allow": [{"action": "*"}]}
+			}
+		]`),
+	)
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v2/iam/resourceGroup?details=true",
+		httpmock.NewStringResponder(200, `[
+			{
+				"id": "rg-1",
+				"urn": "urn:v1:eu:resourceGroup:rg-1",
+				"name": "web",
+				"owner": "aa1-ovh",
+				"resources": [{"urn": "urn:v1:eu:resource:vps:vps-1234.vps.ovh.net"}]
+			}
+		]`),
+	)
+	dir := require.TempDir()
+
+	files := map[string]string{
+		"policies/ops.yaml": `name: ops
+description: Operators
+identities:
+- urn:v1:eu:identity:group:aa1-ovh/operators
+resources:
+- resourceGroup: web
+- resourceGroup: databases
+- urn: urn:v1:eu:resource:domain:example.com
+  displayName: example.com
+permissionsGroups:
+- vps-readers
+- urn:v2:eu:permissionsGroup:ovh:admin
+allow:
+- vps:apiovh:get
+`,
+		"permissions-groups/vps-readers.yaml": `name: vps-readers
+allow:
+- vps:apiovh:get
+`,
+		"resource-groups/web.yaml": `name: web
+resources:
+- urn: urn:v1:eu:resource:vps:vps-1234.vps.ovh.net
+  displayName: web-server
+`,
+		"resource-groups/databases.yaml": `name: databases
+resources:
+- urn: urn:v1:eu:resource:publicCloudProject:fakeProjectID
+`,
+	}
+	for path, content := range files {
+		require.CmpNoError(os.MkdirAll(filepath.Dir(filepath.Join(dir, path)), 0o755))
+		require.CmpNoError(os.WriteFile(filepath.Join(dir, path), []byte(content), 0o644))
+	}
+
+	httpmock.RegisterMatcherResponder(http.MethodPost, "https://eu.api.ovh.com/v2/iam/resourceGroup",
+		tdhttpmock.JSONBody(td.JSON(`{
+			"name": "databases",
+			"resources": [{"urn": "urn:v1:eu:resource:publicCloudProject:fakeProjectID"}]
+		}`)),
+		httpmock.NewStringResponder(200, `{"id": "rg-2", "urn": "urn:v1:eu:resourceGroup:rg-2", "name": "databases"}`),
+	)
+
+	httpmock.RegisterMatcherResponder(http.MethodPut, "https://eu.api.ovh.com/v2/iam/policy/policy-ops",
+		tdhttpmock.JSONBody(td.JSON(`{
+			"name": "ops",
+			"description": "Operators",
+			"identities": ["urn:v1:eu:identity:group:aa1-ovh/operators"],
+			"resources": Bag(
+				{"urn": "urn:v1:eu:resourceGroup:rg-1"},
+				{"urn": "urn:v1:eu:resourceGroup:rg-2"},
+				{"urn": "urn:v1:eu:resource:domain:example.com"}
+			),
+			"permissions": {"allow": [{"action": "vps:apiovh:get"}]},
+			"permissionsGroups": Bag(
+				{"urn": "urn:v2:eu:permissionsGroup:aa1-ovh:pg-1"},
+				{"urn": "urn:v2:eu:permissionsGroup:ovh:admin"}
+			)
+		}`)),
+		httpmock.NewStringResponder(200, `{}`),
+	)
+
+	httpmock.RegisterResponder(http.MethodDelete, "https://eu.api.ovh.com/v2/iam/policy/policy-legacy",
+		httpmock.NewStringResponder(204, ``),
+	)
+
+	out, err := cmd.Execute("iam", "policy", "import", "--dir", dir, "--prune")
+	require.CmpNoError(err)
+	assert.String(out, `+ resource group databases
+~ policy ops
+    allow: ["vps:apiovh:get","vps:apiovh:reboot"] -> ["vps:apiovh:get"]
+    resources: [{"urn":"urn:v1:eu:resource:domain:example.com"},{"resourceGroup":"web"}] -> [{"urn":"urn:v1:eu:resource:domain:example.com"},{"resourceGroup":"databases"},{"resourceGroup":"web"}]
+- policy legacy
+✅ IAM configuration imported: 1 object(s) created, 1 updated and 1 deleted`)
+}
+
+func (ms *MockSuite) TestIAMResourceListByTagCmd(assert, require *td.T) {
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v2/iam/resource",
+		httpmock.NewStringResponder(200, `[
+			{"urn": "urn:v1:eu:resource:vps:vps-1", "type": "vps", "displayName": "vps-1", "tags": {"env": "prod", "team": "web"}},
+			{"urn": "urn:v1:eu:resource:vps:vps-2", "type": "vps", "displayName": "vps-2", "tags": {"env": "staging"}},
+			{"urn": "urn:v1:eu:resource:domain:example.com", "type": "domain", "displayName": "example.com"}
+		]`),
+	)
+
+	out, err := cmd.Execute("iam", "resource", "list", "--tag", "env=prod", "--show-tags")
+	require.CmpNoError(err)
+	assert.String(out, `
+┌──────────────────────────────┬──────┬─────────────┬───────────────────┐
+│             urn              │ type │ displayName │       tags        │
+├──────────────────────────────┼──────┼─────────────┼───────────────────┤
+│ urn:v1:eu:resource:vps:vps-1 │ vps  │ vps-1       │ env=prod team=web │
+└──────────────────────────────┴──────┴─────────────┴───────────────────┘
+💡 Use option --json or --yaml to get the raw output with all information`[1:])
+}
+
+func (ms *MockSuite) TestIAMResourceTagAddCmd(assert, require *td.T) {
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v2/iam/resource/urn:v1:eu:resource:vps:vps-1",
+		httpmock.NewStringResponder(200, `{"urn": "urn:v1:eu:resource:vps:vps-1", "tags": {"env": "prod"}}`),
+	)
+
+	httpmock.RegisterMatcherResponder(http.MethodPost, "https://eu.api.ovh.com/v2/iam/resource/urn:v1:eu:resource:vps:vps-1/tag",
+		tdhttpmock.JSONBody(td.JSON(`{"key": "team", "value": "web"}`)),
+		httpmock.NewStringResponder(200, `{}`),
+	)
+
+	out, err := cmd.Execute("iam", "resource", "tag", "add", "urn:v1:eu:resource:vps:vps-1", "env=prod", "team=web")
+	require.CmpNoError(err)
+	assert.String(out, `✅ Tags added to 1 resource(s), 0 already up-to-date`)
+}
+
+func (ms *MockSuite) TestIAMResourceTagAddByFilterCmd(assert, require *td.T) {
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v2/iam/resource",
+		httpmock.NewStringResponder(200, `[
+			{"urn": "urn:v1:eu:resource:vps:vps-1", "type": "vps", "displayName": "vps-1", "tags": {"env": "prod", "team": "web"}},
+			{"urn": "urn:v1:eu:resource:vps:vps-2", "type": "vps", "displayName": "vps-2", "tags": {"env": "staging"}},
+			{"urn": "urn:v1:eu:resource:domain:example.com", "type": "domain", "displayName": "example.com"}
+		]`),
+	)
+
+	httpmock.RegisterMatcherResponder(http.MethodPost, "https://eu.api.ovh.com/v2/iam/resource/urn:v1:eu:resource:vps:vps-2/tag",
+		tdhttpmock.JSONBody(td.JSON(`{"key": "env", "value": "prod"}`)),
+		httpmock.NewStringResponder(200, `{}`),
+	)
+
+	out, err := cmd.Execute("iam", "resource", "tag", "add", "--filter", `type=="vps"`, "env=prod", "--json")
+	require.CmpNoError(err)
+	assert.Cmp(json.RawMessage(out), td.JSON(`{
+		"message": "✅ Tags added to 1 resource(s), 1 already up-to-date",
+		"details": {
+			"urn:v1:eu:resource:vps:vps-2": ["env=prod"]
+		}
+	}`))
+}
+
+func (ms *MockSuite) TestIAMResourceTagRemoveByFilterCmd(assert, require *td.T) {
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v2/iam/resource",
+		httpmock.NewStringResponder(200, `[
+			{"urn": "urn:v1:eu:resource:vps:vps-1", "type": "vps", "displayName": "vps-1", "tags": {"env": "prod", "team": "web"}},
+			{"urn": "urn:v1:eu:resource:vps:vps-2", "type": "vps", "displayName": "vps-2", "tags": {"env": "staging"}},
+			{"urn": "urn:v1:eu:resource:domain:example.com", "type": "domain", "displayName": "example.com"}
+		]`),
+	)
+
+	httpmock.RegisterResponder(http.MethodDelete, "https://eu.api.ovh.com/v2/iam/resource/urn:v1:eu:resource:vps:vps-2/tag/env",
+		httpmock.NewStringResponder(204, ``),
+	)
+
+	out, err := cmd.Execute("iam", "resource", "tag", "remove", "--filter", `type=="vps"`, "env=staging")
+	require.CmpNoError(err)
+	assert.String(out, `✅ Tags removed from 1 resource(s), 1 already up-to-date`)
+}
+
+func (ms *MockSuite) TestIAMUserAuditCmd(assert, require *td.T) {
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/me/identity/user",
+		httpmock.NewStringResponder(200, `["ops", "legacy"]`),
+	)
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/me/identity/user/ops",
+		httpmock.NewStringResponder(200, `{"login": "ops", "group": "ADMIN", "status": "OK", "creation": "2020-01-01T00:00:00Z"}`),
+	)
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/me/identity/user/legacy",
+		httpmock.NewStringResponder(200, `{"login": "legacy", "group": "UNPRIVILEGED", "status": "OK", "creation": "2020-01-01T00:00:00Z"}`),
+	)
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/me/identity/user/ops/token",
+		httpmock.NewStringResponder(200, `["ci"]`),
+	)
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/me/identity/user/ops/token/ci",
+		httpmock.NewStringResponder(200, `{"name": "ci", "description": "CI token", "expiresAt": "2099-01-01T00:00:00Z"}`),
+	)
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/me/identity/user/legacy/token",
+		httpmock.NewStringResponder(200, `["old"]`),
+	)
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/me/identity/user/legacy/token/old",
+		httpmock.NewStringResponder(200, `{"name": "old", "description": "Old token"}`),
+	)
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/me/logs/audit",
+		httpmock.NewStringResponder(200, `[
+			{
+				"type": "LOGIN_SUCCESS",
+				"createdAt": "2099-01-01T10:00:00Z",
+				"authDetails": {"userDetails": {"type": "USER", "user": "ops"}},
+				"loginSuccessDetails": {"mfaType": "TOTP"}
+			},
+			{
+				"type": "LOGIN_SUCCESS",
+				"createdAt": "2020-06-01T10:00:00Z",
+				"authDetails": {"userDetails": {"type": "USER", "user": "legacy"}},
+				"loginSuccessDetails": {"mfaType": "NONE"}
+			},
+			{
+				"type": "TOKEN_CREATED",
+				"createdAt": "2099-01-02T10:00:00Z",
+				"authDetails": {"userDetails": {"type": "USER", "user": "legacy"}}
+			}
+		]`),
+	)
+
+	out, err := cmd.Execute("iam", "user", "audit", "--json")
+	require.CmpNoError(err)
+	assert.Cmp(json.RawMessage(out), td.JSON(`[
+		SuperMapOf({
+			"login": "ops",
+			"group": "ADMIN",
+			"status": "OK",
+			"lastLogin": "2099-01-01T10:00:00Z",
+			"mfa": "TOTP",
+			"tokens": "ci: expires 2099-01-01",
+			"tokensCount": 1,
+			"stale": false,
+			"warnings": "",
+			"tokensDetails": [{"name": "ci", "description": "CI token", "expiresAt": "2099-01-01T00:00:00Z"}]
+		}),
+		SuperMapOf({
+			"login": "legacy",
+			"group": "UNPRIVILEGED",
+			"status": "OK",
+			"lastLogin": "2020-06-01T10:00:00Z",
+			"mfa": "none",
+			"tokens": "old: never expires",
+			"tokensCount": 1,
+			"stale": true,
+			"warnings": "no login for more than 90 days, no MFA, token old never expires"
+		})
+	]`))
+}
+
+func (ms *MockSuite) TestIAMUserTokenRotateCmd(assert, require *td.T) {
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/me/identity/user/ops/token/ci-20250101120000",
+		httpmock.NewStringResponder(200, `{
+			"name": "ci-20250101120000",
+			"description": "CI token",
+			"creation": "2025-01-01T12:00:00Z",
+			"expiresAt": "2025-01-31T12:00:00Z"
+		}`),
+	)
+
+	httpmock.RegisterMatcherResponder(http.MethodPost, "https://eu.api.ovh.com/v1/me/identity/user/ops/token",
+		tdhttpmock.JSONBody(td.JSON(`{
+			"name": Re("^ci-\\d{14}$"),
+			"description": "CI token",
+			"expiresIn": 2592000
+		}`)),
+		httpmock.NewStringResponder(200, `{"name": "ci-20251019000000", "token": "fake-token-value"}`),
+	)
+
+	httpmock.RegisterResponder(http.MethodDelete, "https://eu.api.ovh.com/v1/me/identity/user/ops/token/ci-20250101120000",
+		httpmock.NewStringResponder(204, ``),
+	)
+
+	out, err := cmd.Execute("iam", "user", "token", "rotate", "ops", "ci-20250101120000")
+	require.CmpNoError(err)
+	assert.Cmp(out, td.Re(`^✅ Token ci-20250101120000 of user ops replaced by token ci-\d{14}, value: fake-token-value$`))
+}
@@ -22,7 +22,7 @@ func init() {
 		Short:   "List your Ip services",
 		Run:     ip.ListIp,
 	}
-	ipCmd.AddCommand(withFilterFlag(ipListCmd))
+	ipCmd.AddCommand(withIAMTagsFlag(withFilterFlag(ipListCmd)))
 
 	// Command to get a single Ip
 	ipCmd.AddCommand(&cobra.Command{
@@ -24,7 +24,7 @@ func init() {
 		Short:   "List your IpLoadbalancing services",
 		Run:     iploadbalancing.ListIpLoadbalancing,
 	}
-	iploadbalancingCmd.AddCommand(withFilterFlag(iploadbalancingListCmd))
+	iploadbalancingCmd.AddCommand(withIAMTagsFlag(withFilterFlag(iploadbalancingListCmd)))
 
 	// Command to get a single IpLoadbalancing
 	iploadbalancingCmd.AddCommand(&cobra.Command{
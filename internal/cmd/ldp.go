@@ -22,7 +22,7 @@ func init() {
 		Short:   "List your Ldp services",
 		Run:     ldp.ListLdp,
 	}
-	ldpCmd.AddCommand(withFilterFlag(ldpListCmd))
+	ldpCmd.AddCommand(withIAMTagsFlag(withFilterFlag(ldpListCmd)))
 
 	// Command to get a single Ldp
 	ldpCmd.AddCommand(&cobra.Command{
@@ -22,7 +22,7 @@ func init() {
 		Short:   "List your Nutanix services",
 		Run:     nutanix.ListNutanix,
 	}
-	nutanixCmd.AddCommand(withFilterFlag(nutanixListCmd))
+	nutanixCmd.AddCommand(withIAMTagsFlag(withFilterFlag(nutanixListCmd)))
 
 	// Command to get a single Nutanix
 	nutanixCmd.AddCommand(&cobra.Command{
@@ -22,7 +22,7 @@ func init() {
 		Short:   "List your Okms services",
 		Run:     okms.ListOkms,
 	}
-	okmsCmd.AddCommand(withFilterFlag(okmsListCmd))
+	okmsCmd.AddCommand(withIAMTagsFlag(withFilterFlag(okmsListCmd)))
 
 	// Command to get a single Okms
 	okmsCmd.AddCommand(&cobra.Command{
@@ -22,7 +22,7 @@ func init() {
 		Short:   "List your OverTheBox services",
 		Run:     overthebox.ListOverTheBox,
 	}
-	overtheboxCmd.AddCommand(withFilterFlag(overtheboxListCmd))
+	overtheboxCmd.AddCommand(withIAMTagsFlag(withFilterFlag(overtheboxListCmd)))
 
 	// Command to get a single OverTheBox
 	overtheboxCmd.AddCommand(&cobra.Command{
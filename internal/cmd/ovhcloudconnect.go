@@ -22,7 +22,7 @@ func init() {
 		Short:   "List your OvhCloudConnect services",
 		Run:     ovhcloudconnect.ListOvhCloudConnect,
 	}
-	ovhcloudconnectCmd.AddCommand(withFilterFlag(ovhcloudconnectListCmd))
+	ovhcloudconnectCmd.AddCommand(withIAMTagsFlag(withFilterFlag(ovhcloudconnectListCmd)))
 
 	// Command to get a single OvhCloudConnect
 	ovhcloudconnectCmd.AddCommand(&cobra.Command{
@@ -22,7 +22,7 @@ func init() {
 		Short:   "List your PackXDSL services",
 		Run:     packxdsl.ListPackXDSL,
 	}
-	packxdslCmd.AddCommand(withFilterFlag(packxdslListCmd))
+	packxdslCmd.AddCommand(withIAMTagsFlag(withFilterFlag(packxdslListCmd)))
 
 	// Command to get a single PackXDSL
 	packxdslCmd.AddCommand(&cobra.Command{
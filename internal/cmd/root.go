@@ -45,6 +45,7 @@ var (
 	wasmHiddenCommands = []string{
 		"login",
 		"config",
+		"ssh",
 	}
 )
 
@@ -74,6 +75,7 @@ func PostExecute() {
 
 	// Reset all flags to default values
 	flags.GenericFilters = nil
+	flags.ShowIAMTags = false
 	flags.OutputFormatConfig = display.OutputFormat{}
 	flags.ParametersViaEditor = false
 	flags.ParametersFile = ""
@@ -237,3 +239,11 @@ Examples:
 
 	return c
 }
+
+// withIAMTagsFlag adds the flag used to join listed resources with their IAM tags.
+// It must only be added to commands listing resources that have an IAM URN.
+func withIAMTagsFlag(c *cobra.Command) *cobra.Command {
+	c.PersistentFlags().BoolVar(&flags.ShowIAMTags, "show-tags", false, `Display the IAM tags of the listed resources in a "tags" column (tags can then be filtered, e.g. --filter 'iamTags=~"env=prod"')`)
+
+	return c
+}
@@ -22,7 +22,7 @@ func init() {
 		Short:   "List your SMS services",
 		Run:     sms.ListSms,
 	}
-	smsCmd.AddCommand(withFilterFlag(smsListCmd))
+	smsCmd.AddCommand(withIAMTagsFlag(withFilterFlag(smsListCmd)))
 
 	// Command to get a single Sms
 	smsCmd.AddCommand(&cobra.Command{
@@ -0,0 +1,49 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"github.com/ovh/ovhcloud-cli/internal/services/cloud"
+	"github.com/ovh/ovhcloud-cli/internal/services/ssh"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	sshCmd := &cobra.Command{
+		Use:   "ssh [<user>@][<type>/]<target> [-- <ssh arguments>]",
+		Short: "Connect using SSH to a cloud instance, a VPS or a baremetal server",
+		Long: `Connect using SSH to a cloud instance, a VPS or a baremetal server.
+
+The target is resolved to its IP address using the API, and the system ssh client is executed.
+Cloud instances can be given by ID or name, VPS and baremetal servers by service name.
+The type of the target is detected automatically, unless given using the --type flag or a
+prefix (instance/, vps/ or baremetal/).
+
+The user defaults to the one of the instance image, or is guessed from the installed OS.
+
+Examples:
+  # Connect to a cloud instance by name
+  ovhcloud ssh my-instance --cloud-project <project_id>
+
+  # Connect to the private IP of an instance through a bastion instance
+  ovhcloud ssh instance/backend-1 --private --jump bastion
+
+  # Run a command on a VPS
+  ovhcloud ssh root@vps-1234abcd.vps.ovh.net -- uptime`,
+		Run:  ssh.SSH,
+		Args: cobra.MinimumNArgs(1),
+	}
+	sshCmd.Flags().StringVar(&cloud.CloudProject, "cloud-project", "", "Cloud project ID (used for cloud instances)")
+	sshCmd.Flags().StringVar(&ssh.SSHParams.TargetType, "type", "", "Type of the target (instance, vps, baremetal)")
+	sshCmd.Flags().StringVarP(&ssh.SSHParams.User, "user", "l", "", "User to connect as")
+	sshCmd.Flags().BoolVar(&ssh.SSHParams.Private, "private", false, "Connect to the private IP address of the instance")
+	sshCmd.Flags().StringVar(&ssh.SSHParams.Jump, "jump", "", "Jump host (bastion) to connect through, using the same format as the target")
+	sshCmd.Flags().BoolVar(&ssh.SSHParams.JumpPrivate, "jump-private", false, "Use the private IP address of the jump host")
+	sshCmd.Flags().StringVar(&ssh.SSHParams.IdentityFile, "identity-file", "", "Private key file to use")
+	sshCmd.Flags().IntVar(&ssh.SSHParams.Port, "port", 0, "SSH port of the target")
+	sshCmd.Flags().BoolVar(&ssh.SSHParams.PrintOnly, "print", false, "Only print the ssh command instead of running it")
+
+	rootCmd.AddCommand(sshCmd)
+}
@@ -0,0 +1,112 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd_test
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/maxatome/go-testdeep/td"
+	"github.com/ovh/ovhcloud-cli/internal/cmd"
+)
+
+func (ms *MockSuite) TestSSHInstanceWithJumpCmd(assert, require *td.T) {
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/instance",
+		httpmock.NewStringResponder(200, `[
+			{
+				"id": "instance-1",
+				"name": "backend",
+				"region": "GRA11",
+				"ipAddresses": [
+					{"ip": "51.1.1.1", "type": "public", "version": 4},
+					{"ip": "10.0.0.5", "type": "private", "version": 4}
+				],
+				"image": {"name": "Ubuntu 24.04", "user": "ubuntu"}
+			},
+			{
+				"id": "instance-2",
+				"name": "bastion",
+				"region": "GRA11",
+				"ipAddresses": [
+					{"ip": "2001:db8::1", "type": "public", "version": 6},
+					{"ip": "51.2.2.2", "type": "public", "version": 4}
+				],
+				"image": {"name": "Debian 12"}
+			}
+		]`))
+
+	out, err := cmd.Execute("ssh", "instance/backend", "--private", "--jump", "instance/bastion", "--cloud-project", "fakeProjectID", "--print", "--json", "--", "uptime")
+	require.CmpNoError(err)
+	assert.Cmp(json.RawMessage(out), td.JSON(`{
+		"message": "ssh -J debian@51.2.2.2 ubuntu@10.0.0.5 uptime",
+		"details": {
+			"type": "instance",
+			"ip": "10.0.0.5",
+			"user": "ubuntu",
+			"args": ["-J", "debian@51.2.2.2", "ubuntu@10.0.0.5", "uptime"]
+		}
+	}`))
+}
+
+func (ms *MockSuite) TestSSHDetectInstanceWithoutVpsAccessCmd(assert, require *td.T) {
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/vps",
+		httpmock.NewStringResponder(403, `{"class": "Client::Forbidden", "message": "This call has not been granted"}`))
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/dedicated/server",
+		httpmock.NewStringResponder(200, `[]`))
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/instance",
+		httpmock.NewStringResponder(200, `[
+			{
+				"id": "instance-1",
+				"name": "backend",
+				"region": "GRA11",
+				"ipAddresses": [
+					{"ip": "51.1.1.1", "type": "public", "version": 4}
+				],
+				"image": {"name": "Ubuntu 24.04", "user": "ubuntu"}
+			}
+		]`))
+
+	out, err := cmd.Execute("ssh", "backend", "--cloud-project", "fakeProjectID", "--print", "--json")
+	require.CmpNoError(err)
+	assert.Cmp(json.RawMessage(out), td.JSON(`{
+		"message": "ssh ubuntu@51.1.1.1",
+		"details": {
+			"type": "instance",
+			"ip": "51.1.1.1",
+			"user": "ubuntu",
+			"args": ["ubuntu@51.1.1.1"]
+		}
+	}`))
+}
+
+func (ms *MockSuite) TestSSHVpsCmd(assert, require *td.T) {
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/vps",
+		httpmock.NewStringResponder(200, `["vps-1234.vps.ovh.net"]`))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/vps/vps-1234.vps.ovh.net/ips",
+		httpmock.NewStringResponder(200, `["2001:db8::2", "51.3.3.3"]`))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/vps/vps-1234.vps.ovh.net/ips/2001:db8::2",
+		httpmock.NewStringResponder(200, `{"ipAddress": "2001:db8::2", "type": "primary", "version": "v6"}`))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/vps/vps-1234.vps.ovh.net/ips/51.3.3.3",
+		httpmock.NewStringResponder(200, `{"ipAddress": "51.3.3.3", "type": "primary", "version": "v4"}`))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/vps/vps-1234.vps.ovh.net/images/current",
+		httpmock.NewStringResponder(200, `{"id": "image-1", "name": "AlmaLinux 9"}`))
+
+	out, err := cmd.Execute("ssh", "vps-1234.vps.ovh.net", "--print", "--port", "2222", "--json")
+	require.CmpNoError(err)
+	assert.Cmp(json.RawMessage(out), td.JSON(`{
+		"message": "ssh -p 2222 almalinux@51.3.3.3",
+		"details": {
+			"type": "vps",
+			"ip": "51.3.3.3",
+			"user": "almalinux",
+			"args": ["-p", "2222", "almalinux@51.3.3.3"]
+		}
+	}`))
+}
@@ -22,7 +22,7 @@ func init() {
 		Short:   "List your SSL services",
 		Run:     ssl.ListSsl,
 	}
-	sslCmd.AddCommand(withFilterFlag(sslListCmd))
+	sslCmd.AddCommand(withIAMTagsFlag(withFilterFlag(sslListCmd)))
 
 	// Command to get a single Ssl
 	sslCmd.AddCommand(&cobra.Command{
@@ -22,7 +22,7 @@ func init() {
 		Short:   "List your SSL Gateway services",
 		Run:     sslgateway.ListSslGateway,
 	}
-	sslgatewayCmd.AddCommand(withFilterFlag(sslgatewayListCmd))
+	sslgatewayCmd.AddCommand(withIAMTagsFlag(withFilterFlag(sslgatewayListCmd)))
 
 	// Command to get a single SslGateway
 	sslgatewayCmd.AddCommand(&cobra.Command{
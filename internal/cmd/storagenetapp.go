@@ -22,7 +22,7 @@ func init() {
 		Short:   "List your Storage NetApp services",
 		Run:     storagenetapp.ListStorageNetApp,
 	}
-	storagenetappCmd.AddCommand(withFilterFlag(storagenetappListCmd))
+	storagenetappCmd.AddCommand(withIAMTagsFlag(withFilterFlag(storagenetappListCmd)))
 
 	// Command to get a single StorageNetApp
 	storagenetappCmd.AddCommand(&cobra.Command{
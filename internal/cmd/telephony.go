@@ -22,7 +22,7 @@ func init() {
 		Short:   "List your Telephony services",
 		Run:     telephony.ListTelephony,
 	}
-	telephonyCmd.AddCommand(withFilterFlag(telephonyListCmd))
+	telephonyCmd.AddCommand(withIAMTagsFlag(withFilterFlag(telephonyListCmd)))
 
 	// Command to get a single Telephony
 	telephonyCmd.AddCommand(&cobra.Command{
@@ -22,7 +22,7 @@ func init() {
 		Short:   "List your VeeamCloudConnect services",
 		Run:     veeamcloudconnect.ListVeeamCloudConnect,
 	}
-	veeamcloudconnectCmd.AddCommand(withFilterFlag(veeamcloudconnectListCmd))
+	veeamcloudconnectCmd.AddCommand(withIAMTagsFlag(withFilterFlag(veeamcloudconnectListCmd)))
 
 	// Command to get a single VeeamCloudConnect
 	veeamcloudconnectCmd.AddCommand(&cobra.Command{
@@ -22,7 +22,7 @@ func init() {
 		Short:   "List your VeeamEnterprise services",
 		Run:     veeamenterprise.ListVeeamEnterprise,
 	}
-	veeamenterpriseCmd.AddCommand(withFilterFlag(veeamenterpriseListCmd))
+	veeamenterpriseCmd.AddCommand(withIAMTagsFlag(withFilterFlag(veeamenterpriseListCmd)))
 
 	// Command to get a single VeeamEnterprise
 	veeamenterpriseCmd.AddCommand(&cobra.Command{
@@ -22,7 +22,7 @@ func init() {
 		Short:   "List your VmwareCloudDirector Backup services",
 		Run:     vmwareclouddirectorbackup.ListVmwareCloudDirectorBackup,
 	}
-	vmwareclouddirectorbackupCmd.AddCommand(withFilterFlag(vmwareclouddirectorbackupListCmd))
+	vmwareclouddirectorbackupCmd.AddCommand(withIAMTagsFlag(withFilterFlag(vmwareclouddirectorbackupListCmd)))
 
 	// Command to get a single VmwareCloudDirectorBackup
 	vmwareclouddirectorbackupCmd.AddCommand(&cobra.Command{
@@ -22,7 +22,7 @@ func init() {
 		Short:   "List your VmwareCloudDirector Organizations",
 		Run:     vmwareclouddirectororganization.ListVmwareCloudDirectorOrganization,
 	}
-	vmwareclouddirectororganizationCmd.AddCommand(withFilterFlag(vmwareclouddirectororganizationListCmd))
+	vmwareclouddirectororganizationCmd.AddCommand(withIAMTagsFlag(withFilterFlag(vmwareclouddirectororganizationListCmd)))
 
 	// Command to get a single VmwareCloudDirector Organization
 	vmwareclouddirectororganizationCmd.AddCommand(&cobra.Command{
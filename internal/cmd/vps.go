@@ -28,7 +28,7 @@ func init() {
 		Short:   "List your VPS services",
 		Run:     vps.ListVps,
 	}
-	vpsCmd.AddCommand(withFilterFlag(vpsListCmd))
+	vpsCmd.AddCommand(withIAMTagsFlag(withFilterFlag(vpsListCmd)))
 
 	// Command to get a single VPS
 	vpsCmd.AddCommand(&cobra.Command{
@@ -63,3 +63,37 @@ func (ms *MockSuite) TestVpsGetCmd(assert, require *td.T) {
 		}
 	}`))
 }
+
+func (ms *MockSuite) TestVpsListWithTagsCmd(assert, require *td.T) {
+	httpmock.RegisterResponder("GET", "https://eu.api.ovh.com/v1/vps",
+		httpmock.NewStringResponder(200, `["vps-12345","vps-67890"]`).Once())
+
+	httpmock.RegisterResponder("GET", "https://eu.api.ovh.com/v1/vps/vps-12345",
+		httpmock.NewStringResponder(200, `{"name": "vps-12345", "displayName": "VPS 12345", "state": "running", "zone": "Region OpenStack: os-waw2", "iam": {"urn": "urn:v1:eu:resource:vps:vps-12345"}}`).Once())
+
+	httpmock.RegisterResponder("GET", "https://eu.api.ovh.com/v1/vps/vps-67890",
+		httpmock.NewStringResponder(200, `{"name": "vps-67890", "displayName": "VPS 67890", "state": "stopped", "zone": "Region OpenStack: os-gra1", "iam": {"urn": "urn:v1:eu:resource:vps:vps-67890"}}`).Once())
+
+	httpmock.RegisterResponder("GET", "https://eu.api.ovh.com/v2/iam/resource",
+		httpmock.NewStringResponder(200, `[
+			{"urn": "urn:v1:eu:resource:vps:vps-12345", "tags": {"team": "web", "env": "prod"}},
+			{"urn": "urn:v1:eu:resource:vps:vps-67890"}
+		]`).Once())
+
+	out, err := cmd.Execute("vps", "ls", "--show-tags", "--filter", `iamTags=~"env=prod"`)
+
+	require.CmpNoError(err)
+	assert.String(out, `
+┌───────────┬─────────────┬─────────┬───────────────────────────┬───────────────────┐
+│   name    │ displayName │  state  │           zone            │       tags        │
+├───────────┼─────────────┼─────────┼───────────────────────────┼───────────────────┤
+│ vps-12345 │ VPS 12345   │ running │ Region OpenStack: os-waw2 │ env=prod team=web │
+└───────────┴─────────────┴─────────┴───────────────────────────┴───────────────────┘
+💡 Use option --json or --yaml to get the raw output with all information`[1:])
+}
+
+func (ms *MockSuite) TestShowTagsOnlyOnIAMResourcesCmd(assert, require *td.T) {
+	// Cloud SSH keys are not IAM resources, their list cannot be joined with tags
+	_, err := cmd.Execute("cloud", "ssh-key", "list", "--show-tags", "--cloud-project", "fakeProjectID")
+	assert.String(err, "unknown flag: --show-tags")
+}
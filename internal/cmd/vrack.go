@@ -22,7 +22,7 @@ func init() {
 		Short:   "List your vRackservices",
 		Run:     vrack.ListVrack,
 	}
-	vrackCmd.AddCommand(withFilterFlag(vrackListCmd))
+	vrackCmd.AddCommand(withIAMTagsFlag(withFilterFlag(vrackListCmd)))
 
 	// Command to get a single Vrack
 	vrackCmd.AddCommand(&cobra.Command{
@@ -22,7 +22,7 @@ func init() {
 		Short:   "List your vRackServices services",
 		Run:     vrackservices.ListVrackServices,
 	}
-	vrackservicesCmd.AddCommand(withFilterFlag(vrackservicesListCmd))
+	vrackservicesCmd.AddCommand(withIAMTagsFlag(withFilterFlag(vrackservicesListCmd)))
 
 	// Command to get a single VrackServices
 	vrackservicesCmd.AddCommand(&cobra.Command{
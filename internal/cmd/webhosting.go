@@ -22,7 +22,7 @@ func init() {
 		Short:   "List your WebHosting services",
 		Run:     webhosting.ListWebHosting,
 	}
-	webhostingCmd.AddCommand(withFilterFlag(webhostingListCmd))
+	webhostingCmd.AddCommand(withIAMTagsFlag(withFilterFlag(webhostingListCmd)))
 
 	// Command to get a single WebHosting
 	webhostingCmd.AddCommand(&cobra.Command{
@@ -24,7 +24,7 @@ func init() {
 		Short:   "List your XDSL services",
 		Run:     xdsl.ListXdsl,
 	}
-	xdslCmd.AddCommand(withFilterFlag(xdslListCmd))
+	xdslCmd.AddCommand(withIAMTagsFlag(withFilterFlag(xdslListCmd)))
 
 	// Command to get a single Xdsl
 	xdslCmd.AddCommand(&cobra.Command{
@@ -0,0 +1,107 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package display
+
+import (
+	"math"
+	"strings"
+)
+
+const (
+	// Maximum number of characters of the sparklines rendered by RenderChart
+	maxSparklineWidth = 60
+)
+
+var sparklineBlocks = []rune("▁▂▃▄▅▆▇█")
+
+type (
+	// ChartPoint is a single value of a chart series
+	ChartPoint struct {
+		Timestamp int64   `json:"timestamp"`
+		Value     float64 `json:"value"`
+	}
+
+	// ChartSeries is a named series of values displayed by RenderChart
+	ChartSeries struct {
+		Name   string       `json:"name"`
+		Points []ChartPoint `json:"points"`
+	}
+)
+
+// chartSeriesMaps returns the given series as maps, as expected by custom formats
+func chartSeriesMaps(series []ChartSeries) []map[string]any {
+	maps := make([]map[string]any, 0, len(series))
+	for _, s := range series {
+		points := make([]any, 0, len(s.Points))
+		for _, point := range s.Points {
+			points = append(points, map[string]any{
+				"timestamp": point.Timestamp,
+				"value":     point.Value,
+			})
+		}
+		maps = append(maps, map[string]any{
+			"name":   s.Name,
+			"points": points,
+		})
+	}
+
+	return maps
+}
+
+// stats returns the minimum, average, maximum and last values of the series
+func (s ChartSeries) stats() (minValue, avgValue, maxValue, lastValue float64) {
+	if len(s.Points) == 0 {
+		return 0, 0, 0, 0
+	}
+
+	minValue, maxValue = math.Inf(1), math.Inf(-1)
+	var sum float64
+	for _, point := range s.Points {
+		minValue = math.Min(minValue, point.Value)
+		maxValue = math.Max(maxValue, point.Value)
+		sum += point.Value
+	}
+
+	return minValue, sum / float64(len(s.Points)), maxValue, s.Points[len(s.Points)-1].Value
+}
+
+// sparkline renders the given values as a line of block characters. When there
+// are more values than the given width, consecutive values are averaged.
+func sparkline(values []float64, width int) string {
+	if len(values) == 0 || width <= 0 {
+		return ""
+	}
+
+	// Downsample values to fit in the given width
+	if len(values) > width {
+		buckets := make([]float64, width)
+		for i := range buckets {
+			start, end := i*len(values)/width, (i+1)*len(values)/width
+			var sum float64
+			for _, value := range values[start:end] {
+				sum += value
+			}
+			buckets[i] = sum / float64(end-start)
+		}
+		values = buckets
+	}
+
+	minValue, maxValue := math.Inf(1), math.Inf(-1)
+	for _, value := range values {
+		minValue = math.Min(minValue, value)
+		maxValue = math.Max(maxValue, value)
+	}
+
+	var line strings.Builder
+	for _, value := range values {
+		index := 0
+		if maxValue > minValue {
+			index = int(math.Round((value - minValue) / (maxValue - minValue) * float64(len(sparklineBlocks)-1)))
+		}
+		line.WriteRune(sparklineBlocks[index])
+	}
+
+	return line.String()
+}
@@ -0,0 +1,47 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package display
+
+import (
+	"testing"
+
+	"github.com/maxatome/go-testdeep/td"
+)
+
+func TestSparkline(t *testing.T) {
+	td.Cmp(t, sparkline(nil, 10), "")
+	td.Cmp(t, sparkline([]float64{0, 1, 2, 3, 4, 5, 6, 7}, 10), "▁▂▃▄▅▆▇█")
+	td.Cmp(t, sparkline([]float64{3, 3, 3}, 10), "▁▁▁")
+
+	// Values are averaged when they do not fit in the given width
+	td.Cmp(t, sparkline([]float64{0, 0, 7, 7}, 2), "▁█")
+}
+
+func TestChartSeriesStats(t *testing.T) {
+	series := ChartSeries{
+		Name: "node-1",
+		Points: []ChartPoint{
+			{Timestamp: 1, Value: 10},
+			{Timestamp: 2, Value: 30},
+			{Timestamp: 3, Value: 20},
+		},
+	}
+
+	minValue, avgValue, maxValue, lastValue := series.stats()
+	td.Cmp(t, []float64{minValue, avgValue, maxValue, lastValue}, []float64{10, 20, 30, 20})
+}
+
+func TestChartSeriesMaps(t *testing.T) {
+	series := []ChartSeries{
+		{Name: "node-1", Points: []ChartPoint{{Timestamp: 1, Value: 10}}},
+	}
+
+	td.Cmp(t, chartSeriesMaps(series), []map[string]any{
+		{
+			"name":   "node-1",
+			"points": []any{map[string]any{"timestamp": int64(1), "value": float64(10)}},
+		},
+	})
+}
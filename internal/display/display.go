@@ -176,6 +176,111 @@ func RenderTable(values []map[string]any, columnsToDisplay []string, outputForma
 	outputf("%s%s", t, "\n💡 Use option --json or --yaml to get the raw output with all information")
 }
 
+// RenderChart displays the given series as sparklines along with their minimum,
+// average, maximum and last values. Raw points are displayed for other output formats.
+func RenderChart(title, unit string, series []ChartSeries, outputFormat *OutputFormat) {
+	switch {
+	case outputFormat.CustomFormat != "":
+		if err := renderCustomFormat(chartSeriesMaps(series), outputFormat.CustomFormat); err != nil {
+			exitError("error rendering custom format: %s", err)
+		}
+		return
+	case outputFormat.InteractiveOutput:
+		displayInteractive(series)
+		return
+	case outputFormat.YamlOutput:
+		if err := prettyPrintYAML(series); err != nil {
+			exitError("error displaying YAML results: %s", err)
+		}
+		return
+	case outputFormat.JsonOutput:
+		if err := prettyPrintJSON(series); err != nil {
+			exitError("error displaying JSON results: %s", err)
+		}
+		return
+	}
+
+	formatValue := func(value float64) string {
+		return strings.TrimSpace(fmt.Sprintf("%.2f %s", value, unit))
+	}
+
+	rows := make([][]string, 0, len(series))
+	for _, s := range series {
+		values := make([]float64, 0, len(s.Points))
+		for _, point := range s.Points {
+			values = append(values, point.Value)
+		}
+
+		minValue, avgValue, maxValue, lastValue := s.stats()
+		rows = append(rows, []string{
+			s.Name,
+			sparkline(values, maxSparklineWidth),
+			formatValue(minValue),
+			formatValue(avgValue),
+			formatValue(maxValue),
+			formatValue(lastValue),
+		})
+	}
+
+	var (
+		purple = lipgloss.Color("99")
+		gray   = lipgloss.Color("245")
+		green  = lipgloss.Color("42")
+
+		titleStyle     = lipgloss.NewStyle().Foreground(purple).Bold(true)
+		headerStyle    = lipgloss.NewStyle().Foreground(purple).Bold(true).Align(lipgloss.Center)
+		cellStyle      = lipgloss.NewStyle().Padding(0, 1)
+		oddRowStyle    = cellStyle.Foreground(gray)
+		sparklineStyle = cellStyle.Foreground(green)
+	)
+
+	t := table.New().
+		Border(lipgloss.NormalBorder()).
+		BorderStyle(lipgloss.NewStyle().Foreground(purple)).
+		StyleFunc(func(row, col int) lipgloss.Style {
+			switch {
+			case row == table.HeaderRow:
+				return headerStyle
+			case col == 1:
+				return sparklineStyle
+			default:
+				return oddRowStyle
+			}
+		}).
+		Headers("name", "trend", "min", "avg", "max", "last").
+		Rows(rows...)
+
+	outputf("%s\n%s%s", titleStyle.Render(title), t, "\n💡 Use option --json or --yaml to get the raw output with all information")
+}
+
+// ColorizeDiff colors the lines of the given diff according to their prefix:
+// "+" for additions, "~" for modifications and "-" for deletions
+func ColorizeDiff(diff string, outputFormat *OutputFormat) string {
+	if outputFormat.JsonOutput || outputFormat.YamlOutput || outputFormat.InteractiveOutput || outputFormat.CustomFormat != "" {
+		return diff
+	}
+
+	var (
+		createStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("2"))
+		updateStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("3"))
+		deleteStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("1"))
+	)
+
+	lines := strings.Split(diff, "\n")
+	for i, line := range lines {
+		switch {
+		case strings.HasPrefix(line, "+"):
+			lines[i] = createStyle.Render(line)
+		case strings.HasPrefix(line, "~"):
+			lines[i] = updateStyle.Render(line)
+		case strings.HasPrefix(line, "-"):
+			lines[i] = deleteStyle.Render(line)
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
 func RenderConfigTable(cfg *ini.File) {
 	var (
 		rows    [][]string
@@ -112,6 +112,22 @@ func RenderTable(values []map[string]any, columnsToDisplay []string, outputForma
 	}
 }
 
+func RenderChart(_, _ string, series []ChartSeries, outputFormat *OutputFormat) {
+	if outputFormat.CustomFormat != "" {
+		renderCustomFormat(chartSeriesMaps(series), outputFormat.CustomFormat)
+		return
+	}
+
+	if err := prettyPrintJSON(series); err != nil {
+		exitError("error displaying JSON results: %s", err)
+		return
+	}
+}
+
+func ColorizeDiff(diff string, _ *OutputFormat) string {
+	return diff
+}
+
 func RenderConfigTable(cfg *ini.File) {
 	// TODO: untested
 	output := map[string]any{}
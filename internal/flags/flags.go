@@ -23,6 +23,9 @@ var (
 	// Common filters that can be used in all listing commands
 	GenericFilters []string
 
+	// Flag used by listing commands to display the IAM tags of the listed resources
+	ShowIAMTags bool
+
 	// Flag used by all actions that trigger asynchronous tasks to
 	// wait for task completion before exiting
 	WaitForTask bool
@@ -25,6 +25,21 @@ func FilterEditableFields(spec []byte, path, method string, body map[string]any)
 	return pruned, nil
 }
 
+// ValidateRequestBody checks that the given body is valid according to the
+// request body schema of the given operation.
+func ValidateRequestBody(spec []byte, path, method string, body any) error {
+	content, err := getRequestBodyFromSpec(spec, path, method)
+	if err != nil {
+		return err
+	}
+
+	if content == nil || content.Schema == nil || content.Schema.Value == nil {
+		return fmt.Errorf("no request body schema defined for %s %s", method, path)
+	}
+
+	return content.Schema.Value.VisitJSON(body, openapi3.MultiErrors())
+}
+
 func GetOperationRequestExamples(spec []byte, path, method, defaultExample string, replaceValues map[string]any) (map[string]string, error) {
 	content, err := getRequestBodyFromSpec(spec, path, method)
 	if err != nil {
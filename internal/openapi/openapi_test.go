@@ -440,3 +440,51 @@ func TestPruneUnknownFields(t *testing.T) {
 		})
 	})
 }
+
+func TestValidateRequestBody(t *testing.T) {
+	spec := []byte(`{
+	  "openapi": "3.0.0",
+	  "info": { "title": "Test API", "version": "1.0.0" },
+	  "paths": {
+		"/test": {
+		  "put": {
+			"requestBody": {
+			  "content": {
+				"application/json": {
+				  "schema": {
+					"type": "object",
+					"required": ["status"],
+					"properties": {
+					  "status": { "type": "string", "enum": ["enabled", "disabled"] },
+					  "days": { "type": "integer" }
+					}
+				  }
+				}
+			  }
+			},
+			"responses": { "200": { "description": "OK" } }
+		  }
+		}
+	  }
+	}`)
+
+	t.Run("valid body", func(t *testing.T) {
+		err := ValidateRequestBody(spec, "/test", "put", map[string]any{"status": "enabled", "days": float64(30)})
+		td.CmpNoError(t, err)
+	})
+
+	t.Run("invalid enum value", func(t *testing.T) {
+		err := ValidateRequestBody(spec, "/test", "put", map[string]any{"status": "unknown"})
+		td.CmpError(t, err)
+	})
+
+	t.Run("missing required field", func(t *testing.T) {
+		err := ValidateRequestBody(spec, "/test", "put", map[string]any{"days": float64(30)})
+		td.CmpError(t, err)
+	})
+
+	t.Run("unknown path", func(t *testing.T) {
+		err := ValidateRequestBody(spec, "/unknown", "put", map[string]any{})
+		td.CmpError(t, err)
+	})
+}
@@ -10,6 +10,7 @@ import (
 	"io"
 	"log"
 	"maps"
+	"net/netip"
 	"net/url"
 	"os"
 	"strconv"
@@ -550,3 +551,32 @@ func GetBaremetalCompatibleOses(_ *cobra.Command, args []string) {
 
 	display.RenderTable(formattedValues, []string{"source", "name"}, &flags.OutputFormatConfig)
 }
+
+// GetBaremetalSSHTarget returns the main IPv4 address of the given server
+// and the name of its installed OS
+func GetBaremetalSSHTarget(serviceName string) (string, string, error) {
+	var server struct {
+		IP string `json:"ip"`
+		OS string `json:"os"`
+	}
+	if err := httpLib.Client.Get(fmt.Sprintf("/v1/dedicated/server/%s", url.PathEscape(serviceName)), &server); err != nil {
+		return "", "", fmt.Errorf("failed to fetch baremetal %s: %w", serviceName, err)
+	}
+
+	if server.IP != "" {
+		return server.IP, server.OS, nil
+	}
+
+	// Fallback on the IPv4 addresses routed to the server
+	var ips []string
+	if err := httpLib.Client.Get(fmt.Sprintf("/v1/ip?routedTo.serviceName=%s", url.QueryEscape(serviceName)), &ips); err != nil {
+		return "", "", fmt.Errorf("failed to fetch IPs related to baremetal %s: %w", serviceName, err)
+	}
+	for _, block := range ips {
+		if prefix, err := netip.ParsePrefix(block); err == nil && prefix.Addr().Is4() {
+			return prefix.Addr().String(), server.OS, nil
+		}
+	}
+
+	return "", "", fmt.Errorf("baremetal %s has no IPv4 address", serviceName)
+}
@@ -304,3 +304,18 @@ func GetDatabaseInDatabase(_ *cobra.Command, args []string) {
 		"",
 	)
 }
+
+// databaseServiceEndpoint returns the engine of the given database service and
+// the engine-specific endpoint used to manage it
+func databaseServiceEndpoint(projectID, clusterID string) (string, string, error) {
+	var databaseService struct {
+		Engine string `json:"engine"`
+	}
+	if err := httpLib.Client.Get(fmt.Sprintf("/v1/cloud/project/%s/database/service/%s", projectID, url.PathEscape(clusterID)), &databaseService); err != nil {
+		return "", "", fmt.Errorf("failed to fetch database service: %w", err)
+	}
+
+	endpoint := fmt.Sprintf("/v1/cloud/project/%s/database/%s/%s", projectID, url.PathEscape(databaseService.Engine), url.PathEscape(clusterID))
+
+	return databaseService.Engine, endpoint, nil
+}
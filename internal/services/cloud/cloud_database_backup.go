@@ -0,0 +1,217 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cloud
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"time"
+
+	"github.com/ovh/ovhcloud-cli/internal/display"
+	"github.com/ovh/ovhcloud-cli/internal/flags"
+	httpLib "github.com/ovh/ovhcloud-cli/internal/http"
+	"github.com/ovh/ovhcloud-cli/internal/services/common"
+	"github.com/spf13/cobra"
+)
+
+var (
+	cloudDatabaseBackupColumnsToDisplay = []string{"id", "description", "status", "createdAt", "expiresAt"}
+
+	// DatabaseForkParams holds the parameters of the restore and fork commands.
+	DatabaseForkParams struct {
+		BackupID    string
+		PointInTime string
+		Name        string
+	}
+)
+
+// databaseServiceDetails is the configuration of a database service copied when forking it
+type databaseServiceDetails struct {
+	Description    string                  `json:"description"`
+	Plan           string                  `json:"plan"`
+	Version        string                  `json:"version"`
+	Flavor         string                  `json:"flavor"`
+	NodeNumber     int                     `json:"nodeNumber"`
+	NetworkID      string                  `json:"networkId"`
+	SubnetID       string                  `json:"subnetId"`
+	IPRestrictions []databaseIPRestriction `json:"ipRestrictions"`
+	Disk           struct {
+		Size int `json:"size"`
+	} `json:"disk"`
+	Nodes []databaseNode `json:"nodes"`
+}
+
+func ListDatabaseBackups(_ *cobra.Command, args []string) {
+	projectID, err := getConfiguredCloudProject()
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	_, endpoint, err := databaseServiceEndpoint(projectID, args[0])
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	common.ManageListRequest(endpoint+"/backup", "", cloudDatabaseBackupColumnsToDisplay, flags.GenericFilters)
+}
+
+func GetDatabaseBackup(_ *cobra.Command, args []string) {
+	projectID, err := getConfiguredCloudProject()
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	_, endpoint, err := databaseServiceEndpoint(projectID, args[0])
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	common.ManageObjectRequest(endpoint+"/backup", args[1], "")
+}
+
+// forkDatabaseService creates a new database service from a backup or a point in time of
+// the given one, with the same configuration, and waits for it to be ready
+func forkDatabaseService(projectID, sourceID, name, backupID, pointInTime string) (string, error) {
+	engine, sourceEndpoint, err := databaseServiceEndpoint(projectID, sourceID)
+	if err != nil {
+		return "", err
+	}
+
+	var source databaseServiceDetails
+	if err := httpLib.Client.Get(sourceEndpoint, &source); err != nil {
+		return "", fmt.Errorf("failed to fetch database service details: %w", err)
+	}
+	if len(source.Nodes) == 0 {
+		return "", fmt.Errorf("database service %s has no nodes", sourceID)
+	}
+
+	nodeNumber := source.NodeNumber
+	if nodeNumber == 0 {
+		nodeNumber = len(source.Nodes)
+	}
+
+	flavor := source.Flavor
+	if flavor == "" {
+		flavor = source.Nodes[0].Flavor
+	}
+
+	forkFrom := map[string]any{"serviceId": sourceID}
+	if backupID != "" {
+		forkFrom["backupId"] = backupID
+	} else {
+		forkFrom["pointInTime"] = pointInTime
+	}
+
+	body := map[string]any{
+		"description": name,
+		"plan":        source.Plan,
+		"version":     source.Version,
+		"nodesPattern": map[string]any{
+			"flavor": flavor,
+			"number": nodeNumber,
+			"region": source.Nodes[0].Region,
+		},
+		"forkFrom": forkFrom,
+	}
+	if source.NetworkID != "" {
+		body["networkId"] = source.NetworkID
+		body["subnetId"] = source.SubnetID
+	}
+	if len(source.IPRestrictions) > 0 {
+		body["ipRestrictions"] = source.IPRestrictions
+	}
+	if source.Disk.Size > 0 {
+		body["disk"] = map[string]any{"size": source.Disk.Size}
+	}
+
+	var created struct {
+		ID string `json:"id"`
+	}
+	if err := httpLib.Client.Post(fmt.Sprintf("/v1/cloud/project/%s/database/%s", projectID, url.PathEscape(engine)), body, &created); err != nil {
+		return "", fmt.Errorf("failed to create database service: %w", err)
+	}
+
+	log.Printf("Database service %s created, waiting for it to be ready…", created.ID)
+
+	serviceEndpoint := fmt.Sprintf("/v1/cloud/project/%s/database/service/%s", projectID, url.PathEscape(created.ID))
+	if err := waitForCloudResourceStatus(serviceEndpoint, "READY", []string{"ERROR"}, time.Hour); err != nil {
+		return created.ID, fmt.Errorf("database service %s was created but is not ready: %w", created.ID, err)
+	}
+
+	return created.ID, nil
+}
+
+// parseDatabasePointInTime parses the given RFC3339 timestamp, which must not be in the future
+func parseDatabasePointInTime(value string) (time.Time, error) {
+	pointInTime, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid point in time %q, expected a RFC3339 timestamp (e.g. 2025-10-01T12:00:00Z)", value)
+	}
+	if pointInTime.After(time.Now()) {
+		return time.Time{}, fmt.Errorf("point in time %s is in the future", value)
+	}
+
+	return pointInTime, nil
+}
+
+func RestoreDatabase(_ *cobra.Command, args []string) {
+	projectID, err := getConfiguredCloudProject()
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	pointInTime, err := parseDatabasePointInTime(DatabaseForkParams.PointInTime)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	name := DatabaseForkParams.Name
+	if name == "" {
+		name = fmt.Sprintf("%s-restore-%s", args[0], pointInTime.UTC().Format("20060102-150405"))
+	}
+
+	serviceID, err := forkDatabaseService(projectID, args[0], name, "", pointInTime.UTC().Format(time.RFC3339))
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	display.OutputInfo(&flags.OutputFormatConfig, map[string]any{"id": serviceID},
+		"✅ Database service %s restored at %s in new service %s", args[0], DatabaseForkParams.PointInTime, serviceID)
+}
+
+func ForkDatabase(_ *cobra.Command, args []string) {
+	projectID, err := getConfiguredCloudProject()
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	var pointInTime string
+	if DatabaseForkParams.PointInTime != "" {
+		parsed, err := parseDatabasePointInTime(DatabaseForkParams.PointInTime)
+		if err != nil {
+			display.OutputError(&flags.OutputFormatConfig, "%s", err)
+			return
+		}
+		pointInTime = parsed.UTC().Format(time.RFC3339)
+	}
+
+	serviceID, err := forkDatabaseService(projectID, args[0], DatabaseForkParams.Name, DatabaseForkParams.BackupID, pointInTime)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	display.OutputInfo(&flags.OutputFormatConfig, map[string]any{"id": serviceID},
+		"✅ Database service %s forked successfully into %s (id: %s)", args[0], DatabaseForkParams.Name, serviceID)
+}
@@ -0,0 +1,334 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cloud
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/url"
+	"os"
+	"os/exec"
+	"slices"
+	"strconv"
+
+	"github.com/ovh/ovhcloud-cli/internal/display"
+	"github.com/ovh/ovhcloud-cli/internal/flags"
+	httpLib "github.com/ovh/ovhcloud-cli/internal/http"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// DatabaseConnectParams holds the options of the connect command.
+	DatabaseConnectParams struct {
+		Database string
+		User     string
+		Password string
+		CAFile   string
+		Launch   bool
+	}
+
+	// Connection details of the engines supporting the connect command
+	databaseEngineClients = map[string]databaseEngineClient{
+		"postgresql": {
+			components:      []string{"postgresql"},
+			scheme:          "postgres",
+			defaultUser:     "avnadmin",
+			defaultDatabase: "defaultdb",
+			client:          "psql",
+		},
+		"mysql": {
+			components:      []string{"mysql"},
+			scheme:          "mysql",
+			defaultUser:     "avnadmin",
+			defaultDatabase: "defaultdb",
+			client:          "mysql",
+		},
+		"redis": {
+			components:  []string{"redis"},
+			scheme:      "rediss",
+			defaultUser: "default",
+			client:      "redis-cli",
+		},
+		"valkey": {
+			components:  []string{"valkey", "redis"},
+			scheme:      "rediss",
+			defaultUser: "default",
+			client:      "valkey-cli",
+		},
+		"mongodb": {
+			components:      []string{"mongodbSrv", "mongodb"},
+			scheme:          "mongodb+srv",
+			defaultUser:     "admin",
+			defaultDatabase: "admin",
+			client:          "mongosh",
+		},
+	}
+)
+
+type (
+	databaseEngineClient struct {
+		components      []string
+		scheme          string
+		defaultUser     string
+		defaultDatabase string
+		client          string
+	}
+
+	databaseEndpoint struct {
+		Component string `json:"component"`
+		Domain    string `json:"domain"`
+		Port      int    `json:"port"`
+	}
+
+	// databaseConnection holds everything needed to connect to a database service
+	databaseConnection struct {
+		engine   string
+		client   databaseEngineClient
+		endpoint databaseEndpoint
+		user     string
+		password string
+		database string
+		caFile   string
+	}
+)
+
+// address returns the host and port to connect to
+func (c databaseConnection) address() string {
+	if c.endpoint.Port == 0 || c.client.scheme == "mongodb+srv" {
+		return c.endpoint.Domain
+	}
+	return net.JoinHostPort(c.endpoint.Domain, strconv.Itoa(c.endpoint.Port))
+}
+
+// uri builds the engine-specific connection URI
+func (c databaseConnection) uri() string {
+	uri := url.URL{
+		Scheme: c.client.scheme,
+		User:   url.User(c.user),
+		Host:   c.address(),
+	}
+	if c.password != "" {
+		uri.User = url.UserPassword(c.user, c.password)
+	}
+	if c.database != "" {
+		uri.Path = "/" + c.database
+	}
+
+	query := url.Values{}
+	switch c.engine {
+	case "postgresql":
+		if c.caFile != "" {
+			query.Set("sslmode", "verify-full")
+			query.Set("sslrootcert", c.caFile)
+		} else {
+			query.Set("sslmode", "require")
+		}
+	case "mysql":
+		if c.caFile != "" {
+			query.Set("ssl-mode", "VERIFY_IDENTITY")
+			query.Set("ssl-ca", c.caFile)
+		} else {
+			query.Set("ssl-mode", "REQUIRED")
+		}
+	case "mongodb":
+		query.Set("tls", "true")
+		if c.caFile != "" {
+			query.Set("tlsCAFile", c.caFile)
+		}
+	}
+	uri.RawQuery = query.Encode()
+
+	return uri.String()
+}
+
+// clientArgs returns the arguments of the engine command line client
+func (c databaseConnection) clientArgs() []string {
+	switch c.engine {
+	case "mysql":
+		args := []string{"--host", c.endpoint.Domain, "--port", strconv.Itoa(c.endpoint.Port), "--user", c.user}
+		if c.password == "" {
+			args = append(args, "--password")
+		}
+		if c.caFile != "" {
+			args = append(args, "--ssl-mode=VERIFY_IDENTITY", "--ssl-ca="+c.caFile)
+		} else {
+			args = append(args, "--ssl-mode=REQUIRED")
+		}
+		if c.database != "" {
+			args = append(args, c.database)
+		}
+		return args
+
+	case "redis", "valkey":
+		args := []string{"-h", c.endpoint.Domain, "-p", strconv.Itoa(c.endpoint.Port), "--tls", "--user", c.user}
+		if c.password == "" {
+			args = append(args, "--askpass")
+		}
+		if c.caFile != "" {
+			args = append(args, "--cacert", c.caFile)
+		}
+		return args
+
+	default:
+		// The password is never put in the URI given to the client, psql reads it from the
+		// environment and mongosh prompts for it
+		withoutPassword := c
+		withoutPassword.password = ""
+		return []string{withoutPassword.uri()}
+	}
+}
+
+// clientEnv returns the environment variables giving the password to the engine
+// command line client, so that it never appears in the process arguments
+func (c databaseConnection) clientEnv() []string {
+	if c.password == "" {
+		return nil
+	}
+
+	switch c.engine {
+	case "postgresql":
+		return []string{"PGPASSWORD=" + c.password}
+	case "mysql":
+		return []string{"MYSQL_PWD=" + c.password}
+	case "redis", "valkey":
+		return []string{"REDISCLI_AUTH=" + c.password}
+	default:
+		return nil
+	}
+}
+
+// downloadDatabaseCA writes the CA certificate of the given database service in the given file
+func downloadDatabaseCA(endpoint, path string) error {
+	var certificates struct {
+		CA string `json:"ca"`
+	}
+	if err := httpLib.Client.Get(endpoint+"/certificates", &certificates); err != nil {
+		return fmt.Errorf("failed to fetch CA certificate: %w", err)
+	}
+
+	if err := os.WriteFile(path, []byte(certificates.CA), 0o644); err != nil {
+		return fmt.Errorf("failed to write CA certificate: %w", err)
+	}
+
+	return nil
+}
+
+// resolveDatabaseConnection fetches the details of the given database
+// service and builds the parameters needed to connect to it
+func resolveDatabaseConnection(projectID, clusterID string) (*databaseConnection, error) {
+	var service struct {
+		Engine    string             `json:"engine"`
+		Endpoints []databaseEndpoint `json:"endpoints"`
+	}
+	if err := httpLib.Client.Get(fmt.Sprintf("/v1/cloud/project/%s/database/service/%s", projectID, url.PathEscape(clusterID)), &service); err != nil {
+		return nil, fmt.Errorf("failed to fetch database service: %w", err)
+	}
+
+	client, ok := databaseEngineClients[service.Engine]
+	if !ok {
+		return nil, fmt.Errorf("connecting to %s services is not supported", service.Engine)
+	}
+
+	connection := &databaseConnection{
+		engine:   service.Engine,
+		client:   client,
+		user:     DatabaseConnectParams.User,
+		password: DatabaseConnectParams.Password,
+		database: DatabaseConnectParams.Database,
+		caFile:   DatabaseConnectParams.CAFile,
+	}
+	if connection.user == "" {
+		connection.user = client.defaultUser
+	}
+	if connection.database == "" {
+		connection.database = client.defaultDatabase
+	}
+
+	// Select the endpoint of the engine protocol, by order of preference
+	found := false
+	for _, component := range client.components {
+		index := slices.IndexFunc(service.Endpoints, func(endpoint databaseEndpoint) bool { return endpoint.Component == component })
+		if index >= 0 {
+			connection.endpoint = service.Endpoints[index]
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("no %s endpoint found for database service %s", service.Engine, clusterID)
+	}
+
+	return connection, nil
+}
+
+func ConnectDatabase(_ *cobra.Command, args []string) {
+	projectID, err := getConfiguredCloudProject()
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	connection, err := resolveDatabaseConnection(projectID, args[0])
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	if connection.caFile != "" {
+		endpoint := fmt.Sprintf("/v1/cloud/project/%s/database/%s/%s", projectID, url.PathEscape(connection.engine), url.PathEscape(args[0]))
+		if err := downloadDatabaseCA(endpoint, connection.caFile); err != nil {
+			display.OutputError(&flags.OutputFormatConfig, "%s", err)
+			return
+		}
+	}
+
+	var (
+		clientArgs = connection.clientArgs()
+		clientEnv  = connection.clientEnv()
+	)
+
+	if !DatabaseConnectParams.Launch {
+		details := map[string]any{
+			"engine":   connection.engine,
+			"host":     connection.endpoint.Domain,
+			"port":     connection.endpoint.Port,
+			"user":     connection.user,
+			"database": connection.database,
+			"uri":      connection.uri(),
+			"command":  append([]string{connection.client.client}, clientArgs...),
+		}
+		if len(clientEnv) > 0 {
+			details["env"] = clientEnv
+		}
+		display.OutputInfo(&flags.OutputFormatConfig, details, "%s", connection.uri())
+		return
+	}
+
+	clientPath, err := exec.LookPath(connection.client.client)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s client not found in PATH: %s", connection.client.client, err)
+		return
+	}
+
+	log.Printf("Connecting to %s service %s as %s", connection.engine, args[0], connection.user)
+	if connection.password != "" && len(clientEnv) == 0 {
+		log.Printf("%s cannot read the password from the environment, it will prompt for it", connection.client.client)
+	}
+
+	clientCmd := exec.Command(clientPath, clientArgs...)
+	clientCmd.Env = append(os.Environ(), clientEnv...)
+	clientCmd.Stdin = os.Stdin
+	clientCmd.Stdout = os.Stdout
+	clientCmd.Stderr = os.Stderr
+	if err := clientCmd.Run(); err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			os.Exit(exitErr.ExitCode())
+		}
+		display.OutputError(&flags.OutputFormatConfig, "failed to run %s: %s", connection.client.client, err)
+	}
+}
@@ -0,0 +1,140 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cloud
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/ovh/ovhcloud-cli/internal/display"
+	"github.com/ovh/ovhcloud-cli/internal/flags"
+	httpLib "github.com/ovh/ovhcloud-cli/internal/http"
+	"github.com/ovh/ovhcloud-cli/internal/services/common"
+	"github.com/spf13/cobra"
+)
+
+var (
+	cloudDatabaseIntegrationColumnsToDisplay = []string{"id", "type", "sourceServiceId", "destinationServiceId", "status"}
+
+	// DatabaseIntegrationSpec holds the parameters of the integration to create.
+	DatabaseIntegrationSpec struct {
+		DestinationServiceID string            `json:"destinationServiceId"`
+		SourceServiceID      string            `json:"sourceServiceId"`
+		Type                 string            `json:"type"`
+		Parameters           map[string]string `json:"parameters,omitempty"`
+	}
+)
+
+// databaseIntegrationCapability is an integration type supported between two engines,
+// as returned by the database capabilities of the project
+type databaseIntegrationCapability struct {
+	Type              string `json:"type"`
+	SourceEngine      string `json:"sourceEngine"`
+	DestinationEngine string `json:"destinationEngine"`
+}
+
+// validateDatabaseIntegration checks that the given integration type is supported
+// between the given engines, according to the database capabilities of the project
+func validateDatabaseIntegration(projectID, integrationType, sourceEngine, destinationEngine string) error {
+	var capabilities struct {
+		Integrations []databaseIntegrationCapability `json:"integrations"`
+	}
+	if err := httpLib.Client.Get(fmt.Sprintf("/v1/cloud/project/%s/database/capabilities", projectID), &capabilities); err != nil {
+		return fmt.Errorf("failed to fetch database capabilities: %w", err)
+	}
+
+	var supported []string
+	for _, capability := range capabilities.Integrations {
+		if capability.SourceEngine != sourceEngine || capability.DestinationEngine != destinationEngine {
+			continue
+		}
+		if capability.Type == integrationType {
+			return nil
+		}
+		supported = append(supported, capability.Type)
+	}
+
+	if len(supported) == 0 {
+		return fmt.Errorf("no integration is available from %s to %s", sourceEngine, destinationEngine)
+	}
+
+	slices.Sort(supported)
+	return fmt.Errorf("integration type %q is not available from %s to %s (available types: %s)",
+		integrationType, sourceEngine, destinationEngine, strings.Join(slices.Compact(supported), ", "))
+}
+
+func ListDatabaseIntegrations(_ *cobra.Command, args []string) {
+	projectID, err := getConfiguredCloudProject()
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	_, endpoint, err := databaseServiceEndpoint(projectID, args[0])
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	common.ManageListRequest(endpoint+"/integration", "", cloudDatabaseIntegrationColumnsToDisplay, flags.GenericFilters)
+}
+
+func CreateDatabaseIntegration(_ *cobra.Command, args []string) {
+	projectID, err := getConfiguredCloudProject()
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	sourceEngine, endpoint, err := databaseServiceEndpoint(projectID, args[0])
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	destinationEngine, _, err := databaseServiceEndpoint(projectID, DatabaseIntegrationSpec.DestinationServiceID)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "invalid destination service: %s", err)
+		return
+	}
+
+	if err := validateDatabaseIntegration(projectID, DatabaseIntegrationSpec.Type, sourceEngine, destinationEngine); err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	DatabaseIntegrationSpec.SourceServiceID = args[0]
+
+	var integration map[string]any
+	if err := httpLib.Client.Post(endpoint+"/integration", DatabaseIntegrationSpec, &integration); err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to create integration: %s", err)
+		return
+	}
+
+	display.OutputInfo(&flags.OutputFormatConfig, integration, "✅ Integration %s created successfully (id: %s)", DatabaseIntegrationSpec.Type, integration["id"])
+}
+
+func DeleteDatabaseIntegration(_ *cobra.Command, args []string) {
+	projectID, err := getConfiguredCloudProject()
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	_, endpoint, err := databaseServiceEndpoint(projectID, args[0])
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	if err := httpLib.Client.Delete(fmt.Sprintf("%s/integration/%s", endpoint, url.PathEscape(args[1])), nil); err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to delete integration %s: %s", args[1], err)
+		return
+	}
+
+	display.OutputInfo(&flags.OutputFormatConfig, nil, "✅ Integration %s deleted successfully", args[1])
+}
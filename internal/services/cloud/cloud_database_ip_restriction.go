@@ -0,0 +1,207 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cloud
+
+import (
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+
+	"github.com/ovh/ovhcloud-cli/internal/display"
+	filtersLib "github.com/ovh/ovhcloud-cli/internal/filters"
+	"github.com/ovh/ovhcloud-cli/internal/flags"
+	httpLib "github.com/ovh/ovhcloud-cli/internal/http"
+	"github.com/spf13/cobra"
+)
+
+var (
+	cloudDatabaseIPRestrictionColumnsToDisplay = []string{"ip", "description", "status"}
+
+	// DatabaseIPRestrictionDescription is the description of the IP blocks added by the ip-restriction add command.
+	DatabaseIPRestrictionDescription string
+)
+
+// normalizeCIDR returns the given IP block in CIDR notation, adding
+// a /32 or /128 mask to single IP addresses
+func normalizeCIDR(value string) (string, error) {
+	if !strings.Contains(value, "/") {
+		ip := net.ParseIP(value)
+		if ip == nil {
+			return "", fmt.Errorf("invalid IP address %q", value)
+		}
+		if ip.To4() != nil {
+			return ip.String() + "/32", nil
+		}
+		return ip.String() + "/128", nil
+	}
+
+	_, ipNet, err := net.ParseCIDR(value)
+	if err != nil {
+		return "", fmt.Errorf("invalid IP block %q", value)
+	}
+
+	return ipNet.String(), nil
+}
+
+// getDatabaseIPRestrictions returns the engine-specific endpoint of the given
+// database service and its current IP restrictions
+func getDatabaseIPRestrictions(projectID, clusterID string) (string, []databaseIPRestriction, error) {
+	_, endpoint, err := databaseServiceEndpoint(projectID, clusterID)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var service struct {
+		IPRestrictions []databaseIPRestriction `json:"ipRestrictions"`
+	}
+	if err := httpLib.Client.Get(endpoint, &service); err != nil {
+		return "", nil, fmt.Errorf("failed to fetch database service details: %w", err)
+	}
+
+	return endpoint, service.IPRestrictions, nil
+}
+
+// setDatabaseIPRestrictions replaces the IP restrictions of the given database service
+func setDatabaseIPRestrictions(endpoint string, restrictions []databaseIPRestriction) error {
+	body := map[string]any{
+		"ipRestrictions": restrictions,
+	}
+	if err := httpLib.Client.Put(endpoint, body, nil); err != nil {
+		return fmt.Errorf("failed to update IP restrictions: %w", err)
+	}
+
+	return nil
+}
+
+func ListDatabaseIPRestrictions(_ *cobra.Command, args []string) {
+	projectID, err := getConfiguredCloudProject()
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	_, endpoint, err := databaseServiceEndpoint(projectID, args[0])
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	var service struct {
+		IPRestrictions []map[string]any `json:"ipRestrictions"`
+	}
+	if err := httpLib.Client.Get(endpoint, &service); err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to fetch database service details: %s", err)
+		return
+	}
+
+	restrictions, err := filtersLib.FilterLines(service.IPRestrictions, flags.GenericFilters)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to filter results: %s", err)
+		return
+	}
+
+	display.RenderTable(restrictions, cloudDatabaseIPRestrictionColumnsToDisplay, &flags.OutputFormatConfig)
+}
+
+func AddDatabaseIPRestrictions(_ *cobra.Command, args []string) {
+	projectID, err := getConfiguredCloudProject()
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	endpoint, restrictions, err := getDatabaseIPRestrictions(projectID, args[0])
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	// Merge the given IP blocks with the existing ones
+	var (
+		updated = make([]databaseIPRestriction, 0, len(restrictions)+len(args)-1)
+		changed []string
+	)
+	for _, restriction := range restrictions {
+		updated = append(updated, databaseIPRestriction{IP: restriction.IP, Description: restriction.Description})
+	}
+	for _, value := range args[1:] {
+		cidr, err := normalizeCIDR(value)
+		if err != nil {
+			display.OutputError(&flags.OutputFormatConfig, "%s", err)
+			return
+		}
+
+		index := slices.IndexFunc(updated, func(restriction databaseIPRestriction) bool { return restriction.IP == cidr })
+		switch {
+		case index < 0:
+			updated = append(updated, databaseIPRestriction{IP: cidr, Description: DatabaseIPRestrictionDescription})
+			changed = append(changed, cidr)
+		case DatabaseIPRestrictionDescription != "" && updated[index].Description != DatabaseIPRestrictionDescription:
+			updated[index].Description = DatabaseIPRestrictionDescription
+			changed = append(changed, cidr)
+		}
+	}
+
+	if len(changed) == 0 {
+		display.OutputInfo(&flags.OutputFormatConfig, updated, "✅ IP restrictions of database service %s already up-to-date", args[0])
+		return
+	}
+
+	if err := setDatabaseIPRestrictions(endpoint, updated); err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	display.OutputInfo(&flags.OutputFormatConfig, updated, "✅ IP restrictions of database service %s updated (added or changed: %s)", args[0], strings.Join(changed, ", "))
+}
+
+func RemoveDatabaseIPRestrictions(_ *cobra.Command, args []string) {
+	projectID, err := getConfiguredCloudProject()
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	endpoint, restrictions, err := getDatabaseIPRestrictions(projectID, args[0])
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	var toRemove []string
+	for _, value := range args[1:] {
+		cidr, err := normalizeCIDR(value)
+		if err != nil {
+			display.OutputError(&flags.OutputFormatConfig, "%s", err)
+			return
+		}
+		toRemove = append(toRemove, cidr)
+	}
+
+	var (
+		updated = make([]databaseIPRestriction, 0, len(restrictions))
+		removed []string
+	)
+	for _, restriction := range restrictions {
+		if slices.Contains(toRemove, restriction.IP) {
+			removed = append(removed, restriction.IP)
+			continue
+		}
+		updated = append(updated, databaseIPRestriction{IP: restriction.IP, Description: restriction.Description})
+	}
+
+	if len(removed) == 0 {
+		display.OutputInfo(&flags.OutputFormatConfig, updated, "✅ IP restrictions of database service %s already up-to-date", args[0])
+		return
+	}
+
+	if err := setDatabaseIPRestrictions(endpoint, updated); err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	display.OutputInfo(&flags.OutputFormatConfig, updated, "✅ IP restrictions of database service %s updated (removed: %s)", args[0], strings.Join(removed, ", "))
+}
@@ -0,0 +1,220 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cloud
+
+import (
+	"cmp"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/ovh/ovhcloud-cli/internal/display"
+	"github.com/ovh/ovhcloud-cli/internal/flags"
+	httpLib "github.com/ovh/ovhcloud-cli/internal/http"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// Interval between two fetches of the logs when following them
+	databaseLogsPollingInterval = 5 * time.Second
+)
+
+var (
+	// DatabaseLogsFollow indicates whether the logs command keeps polling for new logs.
+	DatabaseLogsFollow bool
+
+	// DatabaseMetricsParams holds the parameters of the metrics command.
+	DatabaseMetricsParams struct {
+		Metric string
+		Period string
+		CSV    bool
+	}
+
+	// Periods accepted by the metrics endpoints
+	databaseMetricsPeriods = []string{"lastHour", "lastDay", "lastWeek", "lastMonth", "lastYear"}
+)
+
+type databaseLogEntry struct {
+	Hostname  string `json:"hostname"`
+	Message   string `json:"message"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+// key returns the identifier used to de-duplicate log entries between two fetches
+func (e databaseLogEntry) key() string {
+	return fmt.Sprintf("%d|%s|%s", e.Timestamp, e.Hostname, e.Message)
+}
+
+func (e databaseLogEntry) String() string {
+	return fmt.Sprintf("%s %s %s", time.Unix(e.Timestamp, 0).UTC().Format(time.RFC3339), e.Hostname, e.Message)
+}
+
+// fetchDatabaseLogs returns the latest logs of the given database service, oldest first
+func fetchDatabaseLogs(endpoint string) ([]databaseLogEntry, error) {
+	var response struct {
+		Logs []databaseLogEntry `json:"logs"`
+	}
+	if err := httpLib.Client.Get(endpoint+"/logs", &response); err != nil {
+		return nil, fmt.Errorf("failed to fetch logs: %w", err)
+	}
+
+	slices.SortStableFunc(response.Logs, func(a, b databaseLogEntry) int {
+		return cmp.Compare(a.Timestamp, b.Timestamp)
+	})
+
+	return response.Logs, nil
+}
+
+// outputDatabaseLogs displays the given log entries
+func outputDatabaseLogs(entries []databaseLogEntry) {
+	lines := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		lines = append(lines, entry.String())
+	}
+
+	display.OutputInfo(&flags.OutputFormatConfig, entries, "%s", strings.Join(lines, "\n"))
+}
+
+func GetDatabaseLogs(_ *cobra.Command, args []string) {
+	projectID, err := getConfiguredCloudProject()
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	_, endpoint, err := databaseServiceEndpoint(projectID, args[0])
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	entries, err := fetchDatabaseLogs(endpoint)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	if len(entries) > 0 || !DatabaseLogsFollow {
+		outputDatabaseLogs(entries)
+	}
+
+	if !DatabaseLogsFollow {
+		return
+	}
+
+	// The endpoint only returns the latest entries, so the entries
+	// of the previous fetch are enough to filter out duplicates
+	seen := make(map[string]bool, len(entries))
+	for _, entry := range entries {
+		seen[entry.key()] = true
+	}
+
+	for {
+		time.Sleep(databaseLogsPollingInterval)
+
+		entries, err := fetchDatabaseLogs(endpoint)
+		if err != nil {
+			display.OutputError(&flags.OutputFormatConfig, "%s", err)
+			return
+		}
+
+		var newEntries []databaseLogEntry
+		current := make(map[string]bool, len(entries))
+		for _, entry := range entries {
+			current[entry.key()] = true
+			if !seen[entry.key()] {
+				newEntries = append(newEntries, entry)
+			}
+		}
+		seen = current
+
+		if len(newEntries) > 0 {
+			outputDatabaseLogs(newEntries)
+		}
+	}
+}
+
+func GetDatabaseMetrics(_ *cobra.Command, args []string) {
+	projectID, err := getConfiguredCloudProject()
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	_, endpoint, err := databaseServiceEndpoint(projectID, args[0])
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	// Without metric, list the available ones
+	if DatabaseMetricsParams.Metric == "" {
+		var metrics []string
+		if err := httpLib.Client.Get(endpoint+"/metric?extended=false", &metrics); err != nil {
+			display.OutputError(&flags.OutputFormatConfig, "failed to fetch available metrics: %s", err)
+			return
+		}
+		slices.Sort(metrics)
+
+		display.OutputInfo(&flags.OutputFormatConfig, metrics, "Available metrics (use --metric to display one of them):\n%s", strings.Join(metrics, "\n"))
+		return
+	}
+
+	if !slices.Contains(databaseMetricsPeriods, DatabaseMetricsParams.Period) {
+		display.OutputError(&flags.OutputFormatConfig, "invalid period %q, expected one of: %s", DatabaseMetricsParams.Period, strings.Join(databaseMetricsPeriods, ", "))
+		return
+	}
+
+	var metric struct {
+		Name    string `json:"name"`
+		Unit    string `json:"unit"`
+		Metrics []struct {
+			Hostname   string `json:"hostname"`
+			DataPoints []struct {
+				Timestamp int64   `json:"timestamp"`
+				Value     float64 `json:"value"`
+			} `json:"dataPoints"`
+		} `json:"metrics"`
+	}
+	if err := httpLib.Client.Get(fmt.Sprintf("%s/metric/%s?extended=false&period=%s", endpoint, url.PathEscape(DatabaseMetricsParams.Metric), url.QueryEscape(DatabaseMetricsParams.Period)), &metric); err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to fetch metric %s: %s", DatabaseMetricsParams.Metric, err)
+		return
+	}
+
+	series := make([]display.ChartSeries, 0, len(metric.Metrics))
+	for _, host := range metric.Metrics {
+		s := display.ChartSeries{Name: host.Hostname}
+		for _, point := range host.DataPoints {
+			s.Points = append(s.Points, display.ChartPoint{Timestamp: point.Timestamp, Value: point.Value})
+		}
+		series = append(series, s)
+	}
+
+	if DatabaseMetricsParams.CSV {
+		var lines []map[string]any
+		for _, s := range series {
+			for _, point := range s.Points {
+				lines = append(lines, map[string]any{
+					"hostname":  s.Name,
+					"timestamp": time.Unix(point.Timestamp, 0).UTC().Format(time.RFC3339),
+					"value":     point.Value,
+				})
+			}
+		}
+
+		content, err := renderCSV(lines, []string{"hostname", "timestamp", "value"})
+		if err != nil {
+			display.OutputError(&flags.OutputFormatConfig, "failed to render CSV: %s", err)
+			return
+		}
+		display.OutputInfo(&flags.OutputFormatConfig, nil, "%s", strings.TrimSuffix(content, "\n"))
+		return
+	}
+
+	title := fmt.Sprintf("%s (%s)", DatabaseMetricsParams.Metric, DatabaseMetricsParams.Period)
+	display.RenderChart(title, metric.Unit, series, &flags.OutputFormatConfig)
+}
@@ -0,0 +1,118 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cloud
+
+import (
+	"net/url"
+
+	"github.com/ovh/ovhcloud-cli/internal/display"
+	"github.com/ovh/ovhcloud-cli/internal/flags"
+	httpLib "github.com/ovh/ovhcloud-cli/internal/http"
+	"github.com/ovh/ovhcloud-cli/internal/services/common"
+	"github.com/spf13/cobra"
+)
+
+var (
+	cloudDatabaseUserColumnsToDisplay = []string{"id", "username", "status", "createdAt"}
+
+	// DatabaseUserSpec holds the parameters of the database user to create.
+	DatabaseUserSpec struct {
+		Name  string   `json:"name"`
+		Roles []string `json:"roles,omitempty"`
+	}
+)
+
+func ListDatabaseUsers(_ *cobra.Command, args []string) {
+	projectID, err := getConfiguredCloudProject()
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	_, endpoint, err := databaseServiceEndpoint(projectID, args[0])
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	common.ManageListRequest(endpoint+"/user", "", cloudDatabaseUserColumnsToDisplay, flags.GenericFilters)
+}
+
+func CreateDatabaseUser(_ *cobra.Command, args []string) {
+	projectID, err := getConfiguredCloudProject()
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	_, endpoint, err := databaseServiceEndpoint(projectID, args[0])
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	DatabaseUserSpec.Name = args[1]
+
+	var user map[string]any
+	if err := httpLib.Client.Post(endpoint+"/user", DatabaseUserSpec, &user); err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to create user: %s", err)
+		return
+	}
+
+	display.OutputInfo(&flags.OutputFormatConfig, user, "✅ User %s created successfully (id: %s, password: %s)", user["username"], user["id"], user["password"])
+}
+
+func DeleteDatabaseUser(_ *cobra.Command, args []string) {
+	projectID, err := getConfiguredCloudProject()
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	_, endpoint, err := databaseServiceEndpoint(projectID, args[0])
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	if err := httpLib.Client.Delete(endpoint+"/user/"+url.PathEscape(args[1]), nil); err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to delete user: %s", err)
+		return
+	}
+
+	display.OutputInfo(&flags.OutputFormatConfig, nil, "✅ User %s deleted successfully", args[1])
+}
+
+// resetDatabaseUserPassword generates new credentials for the given user and returns them
+func resetDatabaseUserPassword(endpoint, userID string) (map[string]any, error) {
+	var user map[string]any
+	if err := httpLib.Client.Post(endpoint+"/user/"+url.PathEscape(userID)+"/credentials/reset", nil, &user); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+func ResetDatabaseUserPassword(_ *cobra.Command, args []string) {
+	projectID, err := getConfiguredCloudProject()
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	_, endpoint, err := databaseServiceEndpoint(projectID, args[0])
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	user, err := resetDatabaseUserPassword(endpoint, args[1])
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to reset password of user %s: %s", args[1], err)
+		return
+	}
+
+	display.OutputInfo(&flags.OutputFormatConfig, user, "✅ Password of user %s reset successfully, new password: %s", user["username"], user["password"])
+}
@@ -298,6 +298,26 @@ func CreateInstance(cmd *cobra.Command, args []string) {
 		}
 	}
 
+	// Render user data files, if any
+	if len(InstanceUserDataParams.Files) > 0 {
+		InstanceCreationParameters.UserData, err = buildInstanceUserData(projectID, region)
+		if err != nil {
+			display.OutputError(&flags.OutputFormatConfig, "failed to prepare user data: %s", err)
+			return
+		}
+	}
+
+	// Create the instance group, if requested. It is created last so that
+	// it is not left behind when the other parameters are invalid.
+	if InstanceCreationGroup.Name != "" {
+		InstanceCreationParameters.Group.ID, err = createInstanceGroup(projectID, InstanceCreationGroup.Name, InstanceCreationGroup.Policy, region)
+		if err != nil {
+			display.OutputError(&flags.OutputFormatConfig, "failed to create instance group: %s", err)
+			return
+		}
+		log.Printf("Instance group %s created (ID: %s)", InstanceCreationGroup.Name, InstanceCreationParameters.Group.ID)
+	}
+
 	endpoint := fmt.Sprintf("/v1/cloud/project/%s/region/%s/instance", projectID, region)
 	operation, err := common.CreateResource(
 		cmd,
@@ -308,6 +328,12 @@ func CreateInstance(cmd *cobra.Command, args []string) {
 		assets.CloudOpenapiSchema,
 		[]string{"name", "flavor", "bootFrom", "network"})
 	if err != nil {
+		if InstanceCreationGroup.Name != "" {
+			groupEndpoint := fmt.Sprintf("/v1/cloud/project/%s/instance/group/%s", projectID, url.PathEscape(InstanceCreationParameters.Group.ID))
+			if deleteErr := httpLib.Client.Delete(groupEndpoint, nil); deleteErr != nil {
+				log.Printf("failed to delete instance group %s: %s", InstanceCreationParameters.Group.ID, deleteErr)
+			}
+		}
 		display.OutputError(&flags.OutputFormatConfig, "failed to create instance: %s", err)
 		return
 	}
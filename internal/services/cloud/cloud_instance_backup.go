@@ -0,0 +1,312 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cloud
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ovh/ovhcloud-cli/internal/display"
+	filtersLib "github.com/ovh/ovhcloud-cli/internal/filters"
+	"github.com/ovh/ovhcloud-cli/internal/flags"
+	httpLib "github.com/ovh/ovhcloud-cli/internal/http"
+	"github.com/ovh/ovhcloud-cli/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+var (
+	cloudInstanceSnapshotPruneColumnsToDisplay = []string{"id", "name", "region", "creationDate", "size"}
+
+	// InstanceBackupPolicySpec holds the parameters of an instance backup policy.
+	InstanceBackupPolicySpec struct {
+		Cron     string
+		Rotation int
+		Name     string
+	}
+
+	// InstanceSnapshotPruneParams holds the parameters of the snapshot pruning.
+	InstanceSnapshotPruneParams struct {
+		KeepLast  int
+		OlderThan string
+		DryRun    bool
+	}
+)
+
+type instanceBackupWorkflow struct {
+	ID         string `json:"id"`
+	InstanceID string `json:"instanceId"`
+}
+
+// getInstanceNameAndRegion returns the name and the region of the given instance
+func getInstanceNameAndRegion(projectID, instanceID string) (string, string, error) {
+	var instance struct {
+		Name   string `json:"name"`
+		Region string `json:"region"`
+	}
+	if err := httpLib.Client.Get(fmt.Sprintf("/v1/cloud/project/%s/instance/%s", projectID, url.PathEscape(instanceID)), &instance); err != nil {
+		return "", "", fmt.Errorf("failed to fetch instance details: %w", err)
+	}
+
+	return instance.Name, instance.Region, nil
+}
+
+// getInstanceBackupWorkflows returns the backup workflows of the given instance
+func getInstanceBackupWorkflows(projectID, region, instanceID string) ([]instanceBackupWorkflow, error) {
+	var workflows []instanceBackupWorkflow
+	if err := httpLib.Client.Get(fmt.Sprintf("/v1/cloud/project/%s/region/%s/workflow/backup", projectID, url.PathEscape(region)), &workflows); err != nil {
+		return nil, fmt.Errorf("failed to fetch backup workflows: %w", err)
+	}
+
+	var instanceWorkflows []instanceBackupWorkflow
+	for _, workflow := range workflows {
+		if workflow.InstanceID == instanceID {
+			instanceWorkflows = append(instanceWorkflows, workflow)
+		}
+	}
+
+	return instanceWorkflows, nil
+}
+
+// deleteInstanceBackupWorkflows deletes the given backup workflows
+func deleteInstanceBackupWorkflows(projectID, region string, workflows []instanceBackupWorkflow) error {
+	for _, workflow := range workflows {
+		endpoint := fmt.Sprintf("/v1/cloud/project/%s/region/%s/workflow/backup/%s", projectID, url.PathEscape(region), url.PathEscape(workflow.ID))
+		if err := httpLib.Client.Delete(endpoint, nil); err != nil {
+			return fmt.Errorf("failed to delete backup workflow %s: %w", workflow.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func SetInstanceBackupPolicy(_ *cobra.Command, args []string) {
+	projectID, err := getConfiguredCloudProject()
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	if fields := strings.Fields(InstanceBackupPolicySpec.Cron); len(fields) != 5 {
+		display.OutputError(&flags.OutputFormatConfig, "invalid cron expression %q, expected 5 fields (minute hour day-of-month month day-of-week)", InstanceBackupPolicySpec.Cron)
+		return
+	}
+	if InstanceBackupPolicySpec.Rotation < 1 {
+		display.OutputError(&flags.OutputFormatConfig, "rotation must be at least 1")
+		return
+	}
+
+	name, region, err := getInstanceNameAndRegion(projectID, args[0])
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	existing, err := getInstanceBackupWorkflows(projectID, region, args[0])
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	if InstanceBackupPolicySpec.Name == "" {
+		InstanceBackupPolicySpec.Name = "autobackup-" + name
+	}
+
+	body := map[string]any{
+		"cron":       InstanceBackupPolicySpec.Cron,
+		"instanceId": args[0],
+		"name":       InstanceBackupPolicySpec.Name,
+		"rotation":   InstanceBackupPolicySpec.Rotation,
+	}
+
+	var workflow map[string]any
+	if err := httpLib.Client.Post(fmt.Sprintf("/v1/cloud/project/%s/region/%s/workflow/backup", projectID, url.PathEscape(region)), body, &workflow); err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to create backup workflow: %s", err)
+		return
+	}
+
+	// Backup workflows cannot be updated, the existing ones are replaced once the
+	// new one is created, so that the instance is never left without backup policy
+	if err := deleteInstanceBackupWorkflows(projectID, region, existing); err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "new backup workflow created, but %s", err)
+		return
+	}
+
+	display.OutputInfo(&flags.OutputFormatConfig, workflow, "✅ Backup policy of instance %s set (cron: %s, rotation: %d)",
+		args[0], InstanceBackupPolicySpec.Cron, InstanceBackupPolicySpec.Rotation)
+}
+
+func GetInstanceBackupPolicy(_ *cobra.Command, args []string) {
+	projectID, err := getConfiguredCloudProject()
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	_, region, err := getInstanceNameAndRegion(projectID, args[0])
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	endpoint := fmt.Sprintf("/v1/cloud/project/%s/region/%s/workflow/backup", projectID, url.PathEscape(region))
+	workflows, err := httpLib.FetchArray(endpoint, "")
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to fetch backup workflows: %s", err)
+		return
+	}
+
+	for _, workflow := range workflows {
+		if object, ok := workflow.(map[string]any); ok && object["instanceId"] == args[0] {
+			display.OutputObject(object, args[0], "", &flags.OutputFormatConfig)
+			return
+		}
+	}
+
+	display.OutputError(&flags.OutputFormatConfig, "no backup policy defined for instance %s", args[0])
+}
+
+func DeleteInstanceBackupPolicy(_ *cobra.Command, args []string) {
+	projectID, err := getConfiguredCloudProject()
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	_, region, err := getInstanceNameAndRegion(projectID, args[0])
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	workflows, err := getInstanceBackupWorkflows(projectID, region, args[0])
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+	if len(workflows) == 0 {
+		display.OutputError(&flags.OutputFormatConfig, "no backup policy defined for instance %s", args[0])
+		return
+	}
+
+	if err := deleteInstanceBackupWorkflows(projectID, region, workflows); err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	display.OutputInfo(&flags.OutputFormatConfig, nil, "✅ Backup policy of instance %s deleted successfully", args[0])
+}
+
+// selectSnapshotsToPrune returns the snapshots to delete among the given ones: the
+// keepLast most recent snapshots are kept, and only the snapshots created before
+// the given time are deleted, if not zero
+func selectSnapshotsToPrune(snapshots []map[string]any, keepLast int, olderThan time.Time) ([]map[string]any, error) {
+	type datedSnapshot struct {
+		snapshot map[string]any
+		date     time.Time
+	}
+
+	dated := make([]datedSnapshot, 0, len(snapshots))
+	for _, snapshot := range snapshots {
+		rawDate, _ := snapshot["creationDate"].(string)
+		date, err := time.Parse(time.RFC3339, rawDate)
+		if err != nil {
+			return nil, fmt.Errorf("invalid creation date for snapshot %s: %w", snapshot["id"], err)
+		}
+		dated = append(dated, datedSnapshot{snapshot, date})
+	}
+
+	// Most recent snapshots first
+	sort.SliceStable(dated, func(i, j int) bool { return dated[i].date.After(dated[j].date) })
+
+	var toPrune []map[string]any
+	for i, snapshot := range dated {
+		if i < keepLast {
+			continue
+		}
+		if !olderThan.IsZero() && !snapshot.date.Before(olderThan) {
+			continue
+		}
+		toPrune = append(toPrune, snapshot.snapshot)
+	}
+
+	return toPrune, nil
+}
+
+func PruneInstanceSnapshots(_ *cobra.Command, _ []string) {
+	projectID, err := getConfiguredCloudProject()
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	if InstanceSnapshotPruneParams.KeepLast <= 0 && InstanceSnapshotPruneParams.OlderThan == "" {
+		display.OutputError(&flags.OutputFormatConfig, "at least one of --keep-last or --older-than must be given")
+		return
+	}
+
+	var olderThan time.Time
+	if InstanceSnapshotPruneParams.OlderThan != "" {
+		age, err := utils.ParseDuration(InstanceSnapshotPruneParams.OlderThan)
+		if err != nil {
+			display.OutputError(&flags.OutputFormatConfig, "invalid value for --older-than: %s", err)
+			return
+		}
+		olderThan = time.Now().Add(-age)
+	}
+
+	var snapshots []map[string]any
+	if err := httpLib.Client.Get(fmt.Sprintf("/v1/cloud/project/%s/snapshot", projectID), &snapshots); err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to fetch snapshots: %s", err)
+		return
+	}
+
+	snapshots, err = filtersLib.FilterLines(snapshots, flags.GenericFilters)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to filter results: %s", err)
+		return
+	}
+
+	toPrune, err := selectSnapshotsToPrune(snapshots, InstanceSnapshotPruneParams.KeepLast, olderThan)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	if InstanceSnapshotPruneParams.DryRun {
+		log.Printf("Dry run: %d snapshot(s) out of %d would be deleted", len(toPrune), len(snapshots))
+		display.RenderTable(toPrune, cloudInstanceSnapshotPruneColumnsToDisplay, &flags.OutputFormatConfig)
+		return
+	}
+
+	var (
+		deleted = []string{}
+		errs    []error
+	)
+	for _, snapshot := range toPrune {
+		id, ok := snapshot["id"].(string)
+		if !ok {
+			errs = append(errs, fmt.Errorf("snapshot %v has no ID", snapshot["name"]))
+			continue
+		}
+		if err := httpLib.Client.Delete(fmt.Sprintf("/v1/cloud/project/%s/snapshot/%s", projectID, url.PathEscape(id)), nil); err != nil {
+			errs = append(errs, fmt.Errorf("failed to delete snapshot %s: %w", id, err))
+			continue
+		}
+		log.Printf("Snapshot %s (%s) deleted", id, snapshot["name"])
+		deleted = append(deleted, id)
+	}
+
+	if len(errs) > 0 {
+		display.OutputError(&flags.OutputFormatConfig, "%d snapshot(s) deleted, but some deletions failed: %s", len(deleted), errors.Join(errs...))
+		return
+	}
+
+	display.OutputInfo(&flags.OutputFormatConfig, map[string]any{"deleted": deleted}, "✅ %d snapshot(s) deleted", len(deleted))
+}
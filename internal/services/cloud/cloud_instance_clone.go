@@ -0,0 +1,467 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cloud
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/ovh/ovhcloud-cli/internal/display"
+	"github.com/ovh/ovhcloud-cli/internal/flags"
+	httpLib "github.com/ovh/ovhcloud-cli/internal/http"
+	"github.com/spf13/cobra"
+)
+
+// InstanceCloneParams holds the parameters of an instance clone.
+var InstanceCloneParams struct {
+	Region         string
+	Name           string
+	Flavor         string
+	SnapshotName   string
+	DeleteSnapshot bool
+}
+
+type cloneSourceInstance struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Region      string `json:"region"`
+	IPAddresses []struct {
+		IP        string `json:"ip"`
+		Type      string `json:"type"`
+		NetworkID string `json:"networkId"`
+	} `json:"ipAddresses"`
+	Flavor struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"flavor"`
+	SSHKey *struct {
+		Name string `json:"name"`
+	} `json:"sshKey"`
+}
+
+type cloneSourceVolume struct {
+	ID         string   `json:"id"`
+	Name       string   `json:"name"`
+	Region     string   `json:"region"`
+	Size       int      `json:"size"`
+	Type       string   `json:"type"`
+	AttachedTo []string `json:"attachedTo"`
+}
+
+// waitForCloudResourceStatus polls the given endpoint until the "status" field of
+// the returned object is the target one, or one of the given error statuses
+func waitForCloudResourceStatus(endpoint, targetStatus string, errorStatuses []string, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+
+	for {
+		var resource struct {
+			Status string `json:"status"`
+		}
+		if err := httpLib.Client.Get(endpoint, &resource); err != nil {
+			return err
+		}
+
+		switch {
+		case resource.Status == targetStatus:
+			return nil
+		case slices.Contains(errorStatuses, resource.Status):
+			return fmt.Errorf("invalid status %q", resource.Status)
+		case time.Now().After(deadline):
+			return fmt.Errorf("timeout waiting for status %q (status=%s)", targetStatus, resource.Status)
+		}
+
+		log.Printf("Still waiting for status %q (status=%s)…", targetStatus, resource.Status)
+		time.Sleep(10 * time.Second)
+	}
+}
+
+type cloneSnapshot struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Status string `json:"status"`
+}
+
+// listInstanceSnapshots returns the instance snapshots with the given name in the given region
+func listInstanceSnapshots(projectID, region, name string) ([]cloneSnapshot, error) {
+	var snapshots []cloneSnapshot
+	if err := httpLib.Client.Get(fmt.Sprintf("/v1/cloud/project/%s/snapshot?region=%s", projectID, url.QueryEscape(region)), &snapshots); err != nil {
+		return nil, fmt.Errorf("failed to list snapshots: %w", err)
+	}
+
+	return slices.DeleteFunc(snapshots, func(snapshot cloneSnapshot) bool {
+		return snapshot.Name != name
+	}), nil
+}
+
+// waitForInstanceSnapshot waits for the snapshot with the given name to be
+// active in the given region, and returns its ID. Snapshots whose ID is in
+// the given list were there before the clone started and are ignored.
+func waitForInstanceSnapshot(projectID, region, name string, ignoredIDs []string, timeout time.Duration) (string, error) {
+	deadline := time.Now().Add(timeout)
+
+	for {
+		snapshots, err := listInstanceSnapshots(projectID, region, name)
+		if err != nil {
+			return "", err
+		}
+
+		status := "pending"
+		for _, snapshot := range snapshots {
+			if slices.Contains(ignoredIDs, snapshot.ID) {
+				continue
+			}
+
+			switch snapshot.Status {
+			case "active":
+				return snapshot.ID, nil
+			case "killed", "deleted", "error":
+				return "", fmt.Errorf("snapshot %s is in status %q", snapshot.ID, snapshot.Status)
+			}
+			status = snapshot.Status
+		}
+
+		if time.Now().After(deadline) {
+			return "", fmt.Errorf("timeout waiting for snapshot %q to be active in region %s", name, region)
+		}
+
+		log.Printf("Still waiting for snapshot %q to be active in region %s (status=%s)…", name, region, status)
+		time.Sleep(30 * time.Second)
+	}
+}
+
+// resolveCloneFlavor returns the ID of the flavor with the given name in the given region
+func resolveCloneFlavor(projectID, region, flavorName string) (string, error) {
+	flavors, err := getAvailableFlavors(projectID, region)
+	if err != nil {
+		return "", err
+	}
+
+	flavorID, ok := flavors[flavorName]
+	if !ok {
+		return "", fmt.Errorf("flavor %q is not available in region %s", flavorName, region)
+	}
+
+	return flavorID, nil
+}
+
+// resolveCloneNetworks returns the OpenStack IDs in the target region of the given
+// private networks of the source region
+func resolveCloneNetworks(projectID, sourceRegion, targetRegion string, networkIDs []string) ([]string, error) {
+	if sourceRegion == targetRegion || len(networkIDs) == 0 {
+		return networkIDs, nil
+	}
+
+	var networks []PrivateNetwork
+	if err := httpLib.Client.Get(fmt.Sprintf("/v1/cloud/project/%s/network/private", projectID), &networks); err != nil {
+		return nil, fmt.Errorf("failed to fetch private networks: %w", err)
+	}
+
+	resolved := make([]string, 0, len(networkIDs))
+	for _, networkID := range networkIDs {
+		var found string
+
+		for _, network := range networks {
+			isSource := slices.ContainsFunc(network.Regions, func(details NetworkRegionDetails) bool {
+				return details.Region == sourceRegion && details.OpenstackID == networkID
+			})
+			if !isSource {
+				continue
+			}
+
+			for _, details := range network.Regions {
+				if details.Region == targetRegion {
+					found = details.OpenstackID
+				}
+			}
+			if found == "" {
+				return nil, fmt.Errorf("private network %s is not available in region %s, add it with 'ovhcloud cloud network private region add %s %s'",
+					network.ID, targetRegion, network.ID, targetRegion)
+			}
+		}
+
+		if found == "" {
+			return nil, fmt.Errorf("network %s is not a private network of the project", networkID)
+		}
+		resolved = append(resolved, found)
+	}
+
+	return resolved, nil
+}
+
+// cloneInstanceVolume creates a copy of the given volume in the target region. Data
+// is copied using a volume snapshot when staying in the same region, otherwise an empty
+// volume with the same size and type is created.
+func cloneInstanceVolume(projectID, targetRegion, suffix string, volume cloneSourceVolume) (string, error) {
+	body := map[string]any{
+		"name":   volume.Name + suffix,
+		"region": targetRegion,
+		"size":   volume.Size,
+		"type":   volume.Type,
+	}
+
+	if volume.Region == targetRegion {
+		var snapshot struct {
+			ID string `json:"id"`
+		}
+		if err := httpLib.Client.Post(
+			fmt.Sprintf("/v1/cloud/project/%s/volume/%s/snapshot", projectID, url.PathEscape(volume.ID)),
+			map[string]any{"name": volume.Name + suffix},
+			&snapshot,
+		); err != nil {
+			return "", fmt.Errorf("failed to snapshot volume %s: %w", volume.ID, err)
+		}
+
+		snapshotEndpoint := fmt.Sprintf("/v1/cloud/project/%s/volume/snapshot/%s", projectID, url.PathEscape(snapshot.ID))
+		if err := waitForCloudResourceStatus(snapshotEndpoint, "available", []string{"error"}, time.Hour); err != nil {
+			return "", fmt.Errorf("failed to wait for snapshot of volume %s: %w", volume.ID, err)
+		}
+
+		body["snapshotId"] = snapshot.ID
+	} else {
+		log.Printf("Volume %s is in another region, an empty volume of %d GB will be created", volume.ID, volume.Size)
+	}
+
+	var created struct {
+		ID string `json:"id"`
+	}
+	if err := httpLib.Client.Post(fmt.Sprintf("/v1/cloud/project/%s/volume", projectID), body, &created); err != nil {
+		return "", fmt.Errorf("failed to create copy of volume %s: %w", volume.ID, err)
+	}
+
+	volumeEndpoint := fmt.Sprintf("/v1/cloud/project/%s/volume/%s", projectID, url.PathEscape(created.ID))
+	if err := waitForCloudResourceStatus(volumeEndpoint, "available", []string{"error"}, 30*time.Minute); err != nil {
+		return "", fmt.Errorf("failed to wait for volume %s: %w", created.ID, err)
+	}
+
+	return created.ID, nil
+}
+
+func cloneInstance(projectID, instanceID string) (map[string]any, error) {
+	// Fetch source instance
+	var source cloneSourceInstance
+	if err := httpLib.Client.Get(fmt.Sprintf("/v1/cloud/project/%s/instance/%s", projectID, url.PathEscape(instanceID)), &source); err != nil {
+		return nil, fmt.Errorf("failed to fetch instance details: %w", err)
+	}
+
+	var (
+		targetRegion = source.Region
+		name         = source.Name + "-clone"
+		snapshotName = fmt.Sprintf("%s-clone-%s", source.Name, time.Now().UTC().Format("20060102150405"))
+		flavorID     string
+	)
+	if InstanceCloneParams.Region != "" {
+		targetRegion = InstanceCloneParams.Region
+	}
+	if InstanceCloneParams.Name != "" {
+		name = InstanceCloneParams.Name
+	}
+	if InstanceCloneParams.SnapshotName != "" {
+		snapshotName = InstanceCloneParams.SnapshotName
+	}
+
+	// Flavor IDs are specific to each region, so flavors are looked up by name
+	switch {
+	case InstanceCloneParams.Flavor != "":
+		var err error
+		if flavorID, err = resolveCloneFlavor(projectID, targetRegion, InstanceCloneParams.Flavor); err != nil {
+			return nil, err
+		}
+	case targetRegion == source.Region:
+		flavorID = source.Flavor.ID
+	default:
+		var err error
+		if flavorID, err = resolveCloneFlavor(projectID, targetRegion, source.Flavor.Name); err != nil {
+			return nil, err
+		}
+	}
+
+	// Collect network attachments
+	var (
+		public          bool
+		privateNetworks []string
+	)
+	for _, ip := range source.IPAddresses {
+		switch ip.Type {
+		case "public":
+			public = true
+		case "private":
+			if !slices.Contains(privateNetworks, ip.NetworkID) {
+				privateNetworks = append(privateNetworks, ip.NetworkID)
+			}
+		}
+	}
+	privateNetworks, err := resolveCloneNetworks(projectID, source.Region, targetRegion, privateNetworks)
+	if err != nil {
+		return nil, err
+	}
+
+	// Collect attached volumes
+	var volumes []cloneSourceVolume
+	if err := httpLib.Client.Get(fmt.Sprintf("/v1/cloud/project/%s/volume?region=%s", projectID, url.QueryEscape(source.Region)), &volumes); err != nil {
+		return nil, fmt.Errorf("failed to fetch volumes: %w", err)
+	}
+	volumes = slices.DeleteFunc(volumes, func(volume cloneSourceVolume) bool {
+		return !slices.Contains(volume.AttachedTo, source.ID)
+	})
+
+	// Remember the snapshots that already have the same name, so that only the one
+	// created by the clone is used and deleted afterwards
+	existingSnapshots := make(map[string][]string)
+	for _, region := range slices.Compact([]string{source.Region, targetRegion}) {
+		snapshots, err := listInstanceSnapshots(projectID, region, snapshotName)
+		if err != nil {
+			return nil, err
+		}
+		for _, snapshot := range snapshots {
+			existingSnapshots[region] = append(existingSnapshots[region], snapshot.ID)
+		}
+	}
+
+	// Snapshot the instance, directly copied to the distant region if needed
+	snapshotSpec := map[string]any{"snapshotName": snapshotName}
+	if targetRegion != source.Region {
+		snapshotSpec["distantSnapshotName"] = snapshotName
+		snapshotSpec["distantRegionName"] = targetRegion
+	}
+	if err := httpLib.Client.Post(
+		fmt.Sprintf("/v1/cloud/project/%s/region/%s/instance/%s/snapshot", projectID, url.PathEscape(source.Region), url.PathEscape(source.ID)),
+		snapshotSpec,
+		nil,
+	); err != nil {
+		return nil, fmt.Errorf("failed to create snapshot of instance: %w", err)
+	}
+	log.Printf("⚡️ Snapshot %q of instance %s started…", snapshotName, source.ID)
+
+	snapshotID, err := waitForInstanceSnapshot(projectID, targetRegion, snapshotName, existingSnapshots[targetRegion], 2*time.Hour)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create the new instance from the snapshot
+	network := map[string]any{"public": public}
+	if len(privateNetworks) > 0 {
+		var subnets []struct {
+			ID string `json:"id"`
+		}
+		if err := httpLib.Client.Get(fmt.Sprintf("/v1/cloud/project/%s/region/%s/network/%s/subnet",
+			projectID, url.PathEscape(targetRegion), url.PathEscape(privateNetworks[0])), &subnets); err != nil {
+			return nil, fmt.Errorf("failed to fetch subnets of network %s: %w", privateNetworks[0], err)
+		}
+		if len(subnets) == 0 {
+			return nil, fmt.Errorf("network %s has no subnet in region %s", privateNetworks[0], targetRegion)
+		}
+
+		network["private"] = map[string]any{
+			"network": map[string]any{
+				"id":       privateNetworks[0],
+				"subnetId": subnets[0].ID,
+			},
+		}
+	}
+
+	body := map[string]any{
+		"name":     name,
+		"flavor":   map[string]any{"id": flavorID},
+		"bootFrom": map[string]any{"imageId": snapshotID},
+		"network":  network,
+	}
+	if source.SSHKey != nil && source.SSHKey.Name != "" {
+		body["sshKey"] = map[string]any{"name": source.SSHKey.Name}
+	}
+
+	var operation struct {
+		ID string `json:"id"`
+	}
+	if err := httpLib.Client.Post(fmt.Sprintf("/v1/cloud/project/%s/region/%s/instance", projectID, url.PathEscape(targetRegion)), body, &operation); err != nil {
+		return nil, fmt.Errorf("failed to create instance: %w", err)
+	}
+	log.Printf("⚡️ Creation of instance %s started…", name)
+
+	newInstanceID, err := waitForCloudOperation(projectID, operation.ID, "instance#create", time.Hour)
+	if err != nil {
+		return nil, fmt.Errorf("failed to wait for instance creation: %w", err)
+	}
+	if err := waitForInstanceStatus(projectID, newInstanceID, "ACTIVE"); err != nil {
+		return nil, err
+	}
+
+	// Only one private network can be given at creation, the other ones are attached afterwards
+	for _, networkID := range privateNetworks[min(1, len(privateNetworks)):] {
+		if err := httpLib.Client.Post(
+			fmt.Sprintf("/v1/cloud/project/%s/instance/%s/interface", projectID, url.PathEscape(newInstanceID)),
+			map[string]any{"networkId": networkID},
+			nil,
+		); err != nil {
+			return nil, fmt.Errorf("failed to attach network %s to instance %s: %w", networkID, newInstanceID, err)
+		}
+	}
+
+	// Copy and attach the volumes
+	var clonedVolumes []string
+	for _, volume := range volumes {
+		volumeID, err := cloneInstanceVolume(projectID, targetRegion, "-clone", volume)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := httpLib.Client.Post(
+			fmt.Sprintf("/v1/cloud/project/%s/volume/%s/attach", projectID, url.PathEscape(volumeID)),
+			map[string]string{"instanceId": newInstanceID},
+			nil,
+		); err != nil {
+			return nil, fmt.Errorf("failed to attach volume %s to instance %s: %w", volumeID, newInstanceID, err)
+		}
+		clonedVolumes = append(clonedVolumes, volumeID)
+	}
+
+	result := map[string]any{
+		"id":         newInstanceID,
+		"region":     targetRegion,
+		"snapshotId": snapshotID,
+		"networks":   privateNetworks,
+		"volumes":    clonedVolumes,
+	}
+
+	if InstanceCloneParams.DeleteSnapshot {
+		snapshotIDs := []string{snapshotID}
+
+		// A snapshot copied to another region is also kept in the source region
+		if targetRegion != source.Region {
+			sourceSnapshotID, err := waitForInstanceSnapshot(projectID, source.Region, snapshotName, existingSnapshots[source.Region], 2*time.Hour)
+			if err != nil {
+				return nil, fmt.Errorf("instance %s created but failed to find snapshot in region %s: %w", newInstanceID, source.Region, err)
+			}
+			snapshotIDs = append(snapshotIDs, sourceSnapshotID)
+		}
+
+		for _, id := range snapshotIDs {
+			if err := httpLib.Client.Delete(fmt.Sprintf("/v1/cloud/project/%s/snapshot/%s", projectID, url.PathEscape(id)), nil); err != nil {
+				return nil, fmt.Errorf("instance %s created but failed to delete snapshot %s: %w", newInstanceID, id, err)
+			}
+		}
+		delete(result, "snapshotId")
+	}
+
+	return result, nil
+}
+
+func CloneInstance(_ *cobra.Command, args []string) {
+	projectID, err := getConfiguredCloudProject()
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	result, err := cloneInstance(projectID, args[0])
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to clone instance %s: %s", args[0], err)
+		return
+	}
+
+	display.OutputInfo(&flags.OutputFormatConfig, result, "✅ Instance %s cloned successfully as %s in region %s", args[0], result["id"], result["region"])
+}
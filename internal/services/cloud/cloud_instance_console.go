@@ -0,0 +1,156 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cloud
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/ovh/ovhcloud-cli/internal/display"
+	"github.com/ovh/ovhcloud-cli/internal/flags"
+	httpLib "github.com/ovh/ovhcloud-cli/internal/http"
+	"github.com/ovh/ovhcloud-cli/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+// Size of the end of the previously fetched console log that is searched
+// in a new console log to find where new output starts
+const instanceConsoleLogOverlap = 1024
+
+var (
+	// InstanceConsoleLogParams holds the console-log parameters.
+	InstanceConsoleLogParams struct {
+		Tail     int
+		Follow   bool
+		Interval time.Duration
+	}
+
+	// InstanceVNCOpenBrowser defines if the console URL must be opened in a browser.
+	InstanceVNCOpenBrowser bool
+)
+
+// fetchInstanceConsoleLog returns the serial console output of the given instance
+func fetchInstanceConsoleLog(projectID, instanceID string) (string, error) {
+	endpoint := fmt.Sprintf("/v1/cloud/project/%s/instance/%s/console", projectID, url.PathEscape(instanceID))
+
+	var output string
+	if err := httpLib.Client.Get(endpoint, &output); err != nil {
+		return "", err
+	}
+
+	return output, nil
+}
+
+// tailLines returns the last n lines of the given content, or the
+// whole content if n is not strictly positive
+func tailLines(content string, n int) string {
+	if n <= 0 {
+		return content
+	}
+
+	lines := strings.SplitAfter(content, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	if len(lines) <= n {
+		return content
+	}
+
+	return strings.Join(lines[len(lines)-n:], "")
+}
+
+// newConsoleOutput returns the part of current that was not present in previous.
+// The console log being truncated by the platform once too large, the end of the
+// previous output is searched in the current one when it is not a simple append.
+func newConsoleOutput(previous, current string) string {
+	if strings.HasPrefix(current, previous) {
+		return current[len(previous):]
+	}
+
+	overlap := previous[max(0, len(previous)-instanceConsoleLogOverlap):]
+	if idx := strings.LastIndex(current, overlap); idx >= 0 {
+		return current[idx+len(overlap):]
+	}
+
+	return current
+}
+
+// outputInstanceConsoleLog displays the given part of a console log
+func outputInstanceConsoleLog(output string) {
+	display.OutputInfo(&flags.OutputFormatConfig, map[string]any{"output": output}, "%s", strings.TrimSuffix(output, "\n"))
+}
+
+func GetInstanceConsoleLog(_ *cobra.Command, args []string) {
+	if InstanceConsoleLogParams.Interval <= 0 {
+		display.OutputError(&flags.OutputFormatConfig, "invalid interval %s, it must be strictly positive", InstanceConsoleLogParams.Interval)
+		return
+	}
+
+	projectID, err := getConfiguredCloudProject()
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	output, err := fetchInstanceConsoleLog(projectID, args[0])
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to fetch console log of instance %s: %s", args[0], err)
+		return
+	}
+
+	outputInstanceConsoleLog(tailLines(output, InstanceConsoleLogParams.Tail))
+	if !InstanceConsoleLogParams.Follow {
+		return
+	}
+
+	// In follow mode, new output is displayed until interrupted
+	for {
+		time.Sleep(InstanceConsoleLogParams.Interval)
+
+		current, err := fetchInstanceConsoleLog(projectID, args[0])
+		if err != nil {
+			display.OutputError(&flags.OutputFormatConfig, "failed to fetch console log of instance %s: %s", args[0], err)
+			return
+		}
+
+		if newOutput := newConsoleOutput(output, current); newOutput != "" {
+			outputInstanceConsoleLog(newOutput)
+		}
+		output = current
+	}
+}
+
+func GetInstanceVNC(_ *cobra.Command, args []string) {
+	projectID, err := getConfiguredCloudProject()
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	endpoint := fmt.Sprintf("/v1/cloud/project/%s/instance/%s/vnc", projectID, url.PathEscape(args[0]))
+
+	var console struct {
+		Type string `json:"type"`
+		URL  string `json:"url"`
+	}
+	if err := httpLib.Client.Post(endpoint, nil, &console); err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "error fetching console URL for instance %s: %s", args[0], err)
+		return
+	}
+
+	if InstanceVNCOpenBrowser {
+		if err := utils.OpenBrowser(console.URL); err != nil {
+			log.Printf("Failed to open browser: %s", err)
+		}
+	}
+
+	display.OutputInfo(&flags.OutputFormatConfig, map[string]any{
+		"type": console.Type,
+		"url":  console.URL,
+	}, "✅ Console URL for instance %s: %s", args[0], console.URL)
+}
@@ -0,0 +1,112 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cloud
+
+import (
+	_ "embed"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/ovh/ovhcloud-cli/internal/display"
+	"github.com/ovh/ovhcloud-cli/internal/flags"
+	httpLib "github.com/ovh/ovhcloud-cli/internal/http"
+	"github.com/ovh/ovhcloud-cli/internal/services/common"
+	"github.com/spf13/cobra"
+)
+
+var (
+	cloudprojectInstanceGroupColumnsToDisplay = []string{"id", "name", "region", "type", "instance_ids"}
+
+	//go:embed templates/cloud_instance_group.tmpl
+	cloudInstanceGroupTemplate string
+
+	// InstanceGroupPolicies lists the placement policies of instance groups
+	InstanceGroupPolicies = []string{"affinity", "anti-affinity", "soft-anti-affinity"}
+
+	// InstanceGroupPolicy is the policy of the instance group to create.
+	InstanceGroupPolicy string
+
+	// InstanceCreationGroup holds the instance group to create along with an instance.
+	InstanceCreationGroup struct {
+		Name   string
+		Policy string
+	}
+)
+
+// createInstanceGroup creates an instance group in the given region and returns its ID
+func createInstanceGroup(projectID, name, policy, region string) (string, error) {
+	if !slices.Contains(InstanceGroupPolicies, policy) {
+		return "", fmt.Errorf("invalid policy %q, must be one of %s", policy, strings.Join(InstanceGroupPolicies, ", "))
+	}
+
+	body := map[string]any{
+		"name":   name,
+		"policy": policy,
+		"region": region,
+	}
+
+	var group struct {
+		ID string `json:"id"`
+	}
+	if err := httpLib.Client.Post(fmt.Sprintf("/v1/cloud/project/%s/instance/group", projectID), body, &group); err != nil {
+		return "", err
+	}
+
+	return group.ID, nil
+}
+
+func ListInstanceGroups(_ *cobra.Command, _ []string) {
+	projectID, err := getConfiguredCloudProject()
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	common.ManageListRequestNoExpand(fmt.Sprintf("/v1/cloud/project/%s/instance/group", projectID), cloudprojectInstanceGroupColumnsToDisplay, flags.GenericFilters)
+}
+
+func GetInstanceGroup(_ *cobra.Command, args []string) {
+	projectID, err := getConfiguredCloudProject()
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	common.ManageObjectRequest(fmt.Sprintf("/v1/cloud/project/%s/instance/group", projectID), args[0], cloudInstanceGroupTemplate)
+}
+
+func CreateInstanceGroup(_ *cobra.Command, args []string) {
+	projectID, err := getConfiguredCloudProject()
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	groupID, err := createInstanceGroup(projectID, args[1], InstanceGroupPolicy, args[0])
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to create instance group: %s", err)
+		return
+	}
+
+	display.OutputInfo(&flags.OutputFormatConfig, map[string]any{"id": groupID}, "✅ Instance group %s created successfully (ID: %s)", args[1], groupID)
+}
+
+func DeleteInstanceGroup(_ *cobra.Command, args []string) {
+	projectID, err := getConfiguredCloudProject()
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	endpoint := fmt.Sprintf("/v1/cloud/project/%s/instance/group/%s", projectID, url.PathEscape(args[0]))
+	if err := httpLib.Client.Delete(endpoint, nil); err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "error deleting instance group %q: %s", args[0], err)
+		return
+	}
+
+	display.OutputInfo(&flags.OutputFormatConfig, nil, "✅ Instance group %s deleted successfully", args[0])
+}
@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cloud
+
+import (
+	"fmt"
+	"strings"
+
+	httpLib "github.com/ovh/ovhcloud-cli/internal/http"
+)
+
+// GetInstanceSSHTarget resolves the given instance ID or name to the IPv4 address to connect
+// to, the default user of its image and the image name. If the name matches several instances,
+// an error is returned.
+func GetInstanceSSHTarget(nameOrID string, private bool) (string, string, string, error) {
+	projectID, err := getConfiguredCloudProject()
+	if err != nil {
+		return "", "", "", err
+	}
+
+	var instances []struct {
+		ID          string `json:"id"`
+		Name        string `json:"name"`
+		Region      string `json:"region"`
+		IPAddresses []struct {
+			IP      string `json:"ip"`
+			Type    string `json:"type"`
+			Version int    `json:"version"`
+		} `json:"ipAddresses"`
+		Image struct {
+			Name string `json:"name"`
+			User string `json:"user"`
+		} `json:"image"`
+	}
+	if err := httpLib.Client.Get(fmt.Sprintf("/v1/cloud/project/%s/instance", projectID), &instances); err != nil {
+		return "", "", "", fmt.Errorf("failed to list instances: %w", err)
+	}
+
+	var matches []int
+	for i, instance := range instances {
+		if instance.ID == nameOrID {
+			matches = []int{i}
+			break
+		}
+		if instance.Name == nameOrID {
+			matches = append(matches, i)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return "", "", "", fmt.Errorf("no instance found with ID or name %q", nameOrID)
+	case 1:
+	default:
+		var ids []string
+		for _, i := range matches {
+			ids = append(ids, fmt.Sprintf("%s (%s)", instances[i].ID, instances[i].Region))
+		}
+		return "", "", "", fmt.Errorf("several instances are named %q, use one of their IDs: %s", nameOrID, strings.Join(ids, ", "))
+	}
+
+	instance := instances[matches[0]]
+
+	ipType := "public"
+	if private {
+		ipType = "private"
+	}
+	for _, ip := range instance.IPAddresses {
+		if ip.Type == ipType && ip.Version == 4 {
+			return ip.IP, instance.Image.User, instance.Image.Name, nil
+		}
+	}
+
+	return "", "", "", fmt.Errorf("instance %s has no %s IPv4 address", nameOrID, ipType)
+}
@@ -0,0 +1,304 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cloud
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"mime/multipart"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/ghodss/yaml"
+	"github.com/ovh/ovhcloud-cli/internal/display"
+	"github.com/ovh/ovhcloud-cli/internal/flags"
+	"github.com/spf13/cobra"
+)
+
+// Boundary used to assemble multipart user data, fixed so that
+// the rendered payload is reproducible
+const instanceUserDataBoundary = "==OVHCLOUD-CLI-USER-DATA-BOUNDARY=="
+
+var (
+	// InstanceUserDataParams holds the user data files and template variables.
+	// Region is only used when rendering user data outside of an instance creation.
+	InstanceUserDataParams struct {
+		Files  []string
+		Vars   map[string]string
+		Region string
+	}
+
+	// Content types of user data parts, detected from their first line
+	instanceUserDataContentTypes = []struct {
+		prefix      string
+		contentType string
+	}{
+		{"#cloud-config", "text/cloud-config"},
+		{"#cloud-boothook", "text/cloud-boothook"},
+		{"#include", "text/x-include-url"},
+		{"#part-handler", "text/part-handler"},
+		{"#!", "text/x-shellscript"},
+	}
+
+	// Expected kinds of the most common cloud-config modules
+	instanceCloudConfigKinds = map[string]string{
+		"bootcmd":                    "array",
+		"ca_certs":                   "object",
+		"chpasswd":                   "object",
+		"disable_root":               "boolean",
+		"fqdn":                       "string",
+		"groups":                     "array",
+		"hostname":                   "string",
+		"locale":                     "string",
+		"mounts":                     "array",
+		"package_reboot_if_required": "boolean",
+		"package_update":             "boolean",
+		"package_upgrade":            "boolean",
+		"packages":                   "array",
+		"runcmd":                     "array",
+		"ssh_authorized_keys":        "array",
+		"ssh_pwauth":                 "boolean",
+		"timezone":                   "string",
+		"users":                      "array",
+		"write_files":                "array",
+	}
+)
+
+// instanceUserDataTemplateValues are the variables available in user data templates
+type instanceUserDataTemplateValues struct {
+	name    string
+	Region  string
+	Project string
+	Vars    map[string]string
+}
+
+// Name returns the name of the instance, failing when it is not known
+// so that templates never render an empty name
+func (v instanceUserDataTemplateValues) Name() (string, error) {
+	if v.name == "" {
+		return "", errors.New("instance name is unknown, give it using --name")
+	}
+
+	return v.name, nil
+}
+
+// detectInstanceUserDataContentType returns the MIME type of a user data part
+func detectInstanceUserDataContentType(content string) (string, error) {
+	for _, candidate := range instanceUserDataContentTypes {
+		if strings.HasPrefix(content, candidate.prefix) {
+			return candidate.contentType, nil
+		}
+	}
+
+	return "", errors.New("unknown user data type, content must start with #cloud-config, #cloud-boothook, #include, #part-handler or #! (shell script)")
+}
+
+// jsonKind returns the JSON kind of the given decoded value
+func jsonKind(value any) string {
+	switch value.(type) {
+	case []any:
+		return "array"
+	case map[string]any:
+		return "object"
+	case bool:
+		return "boolean"
+	case string:
+		return "string"
+	case float64:
+		return "number"
+	case nil:
+		return "null"
+	default:
+		return fmt.Sprintf("%T", value)
+	}
+}
+
+// validateCloudConfig checks that the given cloud-config document is valid YAML,
+// and that the most common modules have the expected types
+func validateCloudConfig(content string) error {
+	jsonContent, err := yaml.YAMLToJSON([]byte(content))
+	if err != nil {
+		return fmt.Errorf("invalid YAML: %w", err)
+	}
+
+	var document map[string]any
+	if err := json.Unmarshal(jsonContent, &document); err != nil {
+		return errors.New("cloud-config must be a YAML mapping")
+	}
+
+	var errs []error
+	for key, value := range document {
+		expected, ok := instanceCloudConfigKinds[key]
+		if !ok {
+			continue
+		}
+		if kind := jsonKind(value); kind != expected {
+			errs = append(errs, fmt.Errorf("%q must be of type %s, got %s", key, expected, kind))
+		}
+	}
+
+	if files, ok := document["write_files"].([]any); ok {
+		for i, file := range files {
+			if entry, ok := file.(map[string]any); !ok || entry["path"] == nil {
+				errs = append(errs, fmt.Errorf("write_files[%d] must be a mapping with a path", i))
+			}
+		}
+	}
+
+	// Sort errors to get a stable output
+	sort.Slice(errs, func(i, j int) bool { return errs[i].Error() < errs[j].Error() })
+
+	return errors.Join(errs...)
+}
+
+// renderInstanceUserData renders the user data files with the given values. A single
+// file is returned as is, several files are assembled in a MIME multipart document.
+func renderInstanceUserData(files []string, values instanceUserDataTemplateValues) (string, error) {
+	type part struct {
+		name        string
+		contentType string
+		content     string
+	}
+
+	var parts []part
+	for _, file := range files {
+		raw, err := os.ReadFile(file)
+		if err != nil {
+			return "", fmt.Errorf("failed to read user data file: %w", err)
+		}
+
+		tmpl, err := template.New(filepath.Base(file)).Option("missingkey=error").Parse(string(raw))
+		if err != nil {
+			return "", fmt.Errorf("invalid template in %s: %w", file, err)
+		}
+
+		var rendered bytes.Buffer
+		if err := tmpl.Execute(&rendered, values); err != nil {
+			return "", fmt.Errorf("failed to render %s: %w", file, err)
+		}
+
+		contentType, err := detectInstanceUserDataContentType(rendered.String())
+		if err != nil {
+			return "", fmt.Errorf("%s: %w", file, err)
+		}
+
+		if contentType == "text/cloud-config" {
+			if err := validateCloudConfig(rendered.String()); err != nil {
+				return "", fmt.Errorf("invalid cloud-config in %s: %w", file, err)
+			}
+		}
+
+		parts = append(parts, part{
+			name:        filepath.Base(file),
+			contentType: contentType,
+			content:     rendered.String(),
+		})
+	}
+
+	switch len(parts) {
+	case 0:
+		return "", errors.New("no user data file given")
+	case 1:
+		return parts[0].content, nil
+	}
+
+	var out bytes.Buffer
+	out.WriteString("Content-Type: multipart/mixed; boundary=\"" + instanceUserDataBoundary + "\"\n")
+	out.WriteString("MIME-Version: 1.0\n\n")
+
+	writer := multipart.NewWriter(&out)
+	if err := writer.SetBoundary(instanceUserDataBoundary); err != nil {
+		return "", err
+	}
+
+	for _, p := range parts {
+		if strings.Contains(p.content, instanceUserDataBoundary) {
+			return "", fmt.Errorf("%s contains the multipart boundary %q", p.name, instanceUserDataBoundary)
+		}
+
+		header := make(textproto.MIMEHeader)
+		header.Set("Content-Type", p.contentType+"; charset=\"utf-8\"")
+		header.Set("MIME-Version", "1.0")
+		header.Set("Content-Transfer-Encoding", "7bit")
+		header.Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", p.name))
+
+		partWriter, err := writer.CreatePart(header)
+		if err != nil {
+			return "", err
+		}
+		if _, err := partWriter.Write([]byte(p.content)); err != nil {
+			return "", err
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return "", err
+	}
+
+	return out.String(), nil
+}
+
+// instanceUserDataName returns the name of the instance to create. Parameters given
+// using --from-file are only merged at creation, so the name is read from the file
+// when --name is not given.
+func instanceUserDataName() (string, error) {
+	if InstanceCreationParameters.Name != "" || flags.ParametersFile == "" {
+		return InstanceCreationParameters.Name, nil
+	}
+
+	content, err := os.ReadFile(flags.ParametersFile)
+	if err != nil {
+		return "", fmt.Errorf("failed to read given file: %w", err)
+	}
+
+	var parameters struct {
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal(content, &parameters); err != nil {
+		return "", fmt.Errorf("failed to parse given file: %w", err)
+	}
+
+	return parameters.Name, nil
+}
+
+// buildInstanceUserData renders the user data files given on the command line
+// for an instance created in the given region
+func buildInstanceUserData(projectID, region string) (string, error) {
+	name, err := instanceUserDataName()
+	if err != nil {
+		return "", err
+	}
+
+	return renderInstanceUserData(InstanceUserDataParams.Files, instanceUserDataTemplateValues{
+		name:    name,
+		Region:  region,
+		Project: projectID,
+		Vars:    InstanceUserDataParams.Vars,
+	})
+}
+
+func RenderInstanceUserData(_ *cobra.Command, _ []string) {
+	// Project is only used as a template variable, so it is optional here
+	projectID, err := getConfiguredCloudProject()
+	if err != nil {
+		log.Printf("No cloud project configured, {{.Project}} will be empty: %s", err)
+		projectID = ""
+	}
+
+	userData, err := buildInstanceUserData(projectID, InstanceUserDataParams.Region)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to render user data: %s", err)
+		return
+	}
+
+	display.OutputInfo(&flags.OutputFormatConfig, map[string]any{"userData": userData}, "%s", userData)
+}
@@ -11,6 +11,8 @@ import (
 	"fmt"
 	"log"
 	"net/url"
+	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/ovh/ovhcloud-cli/internal/assets"
@@ -19,6 +21,7 @@ import (
 	"github.com/ovh/ovhcloud-cli/internal/flags"
 	httpLib "github.com/ovh/ovhcloud-cli/internal/http"
 	"github.com/ovh/ovhcloud-cli/internal/services/common"
+	"github.com/ovh/ovhcloud-cli/internal/utils"
 	"github.com/spf13/cobra"
 )
 
@@ -90,6 +93,10 @@ var (
 		Version           string `json:"version,omitempty"`
 		WorkerNodesPolicy string `json:"workerNodesPolicy,omitempty"`
 		Plan              string `json:"plan,omitempty"`
+		Nodepool          struct {
+			FlavorName   string `json:"flavorName,omitempty"`
+			DesiredNodes int    `json:"desiredNodes,omitempty"`
+		} `json:"nodepool,omitzero"`
 	}
 
 	// KubeNodepoolSpec defines the structure for a Kubernetes node pool specification
@@ -119,6 +126,11 @@ var (
 	// KubeIPRestrictions defines the IP restrictions for Kubernetes clusters
 	// It is set by a command line flag
 	KubeIPRestrictions []string
+
+	// KubeCreationViaInteractiveWizard indicates whether to run the interactive
+	// wizard to define the cluster creation parameters.
+	// It is set by a command line flag
+	KubeCreationViaInteractiveWizard bool
 )
 
 type kubeNodepoolSpec struct {
@@ -215,13 +227,29 @@ func CreateKube(cmd *cobra.Command, args []string) {
 		return
 	}
 
+	// Run interactive creation wizard if the flag is set
+	wizardParams, err := GetKubeCreationInteractiveParameters(cmd, args)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to get parameters from interactive wizard: %s", err)
+		return
+	}
+
+	var cliParams any = KubeSpec
+	if wizardParams != nil {
+		cliParams, err = mergeKubeSpecWithParameters(wizardParams)
+		if err != nil {
+			display.OutputError(&flags.OutputFormatConfig, "%s", err)
+			return
+		}
+	}
+
 	endpoint := fmt.Sprintf("/v1/cloud/project/%s/kube", projectID)
 	cluster, err := common.CreateResource(
 		cmd,
 		"/cloud/project/{serviceName}/kube",
 		endpoint,
 		CloudKubeCreationExample,
-		KubeSpec,
+		cliParams,
 		assets.CloudOpenapiSchema,
 		[]string{"region"})
 	if err != nil {
@@ -625,6 +653,353 @@ func GetKubeFlavorInteractiveSelector(cmd *cobra.Command, args []string) (map[st
 	}, nil
 }
 
+// GetKubeCreationInteractiveParameters runs the interactive wizard used to define
+// the parameters of a new MKS cluster. Values already given using command line flags
+// are kept and the corresponding steps are skipped.
+func GetKubeCreationInteractiveParameters(_ *cobra.Command, _ []string) (map[string]any, error) {
+	if !KubeCreationViaInteractiveWizard {
+		return nil, nil
+	}
+
+	projectID, err := getConfiguredCloudProject()
+	if err != nil {
+		return nil, err
+	}
+
+	params := map[string]any{}
+
+	// Select region among the ones where MKS is available
+	regions, err := getCloudRegionsWithFeatureAvailable(projectID, "kubernetes")
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch regions with Kubernetes feature available: %w", err)
+	}
+
+	region := KubeSpec.Region
+	if region == "" {
+		regionChoices := make(map[string]string, len(regions))
+		for _, r := range regions {
+			regionChoices[r.(string)] = r.(string)
+		}
+
+		region, _, err = display.RunGenericChoicePicker("Please select a region", regionChoices, 30)
+		if err != nil {
+			return nil, err
+		}
+		if region == "" {
+			return nil, errors.New("no region selected, exiting")
+		}
+	}
+	params["region"] = region
+
+	// Select Kubernetes version
+	if KubeSpec.Version == "" {
+		version, err := runKubeVersionSelector(projectID, region)
+		if err != nil {
+			return nil, err
+		}
+		if version != "" {
+			params["version"] = version
+		}
+	}
+
+	// Select plan
+	if KubeSpec.Plan == "" {
+		plan, _, err := display.RunGenericChoicePicker("Please select a plan", map[string]string{
+			"free":     "Free control plane, single availability zone",
+			"standard": "Highly available control plane with financially-backed SLA",
+		}, 0)
+		if err != nil {
+			return nil, err
+		}
+		if plan == "" {
+			return nil, errors.New("no plan selected, exiting")
+		}
+		params["plan"] = plan
+	}
+
+	// Select private network, subnets and gateway
+	if KubeSpec.PrivateNetworkId == "" {
+		if err := runKubePrivateNetworkSelectors(projectID, region, params); err != nil {
+			return nil, err
+		}
+	}
+
+	// Select initial node pool
+	if KubeSpec.Nodepool.FlavorName != "" {
+		params["nodepool"] = map[string]any{
+			"flavorName":   KubeSpec.Nodepool.FlavorName,
+			"desiredNodes": KubeSpec.Nodepool.DesiredNodes,
+		}
+	} else {
+		nodepool, err := runKubeNodepoolSelectors(projectID, region)
+		if err != nil {
+			return nil, err
+		}
+		if nodepool != nil {
+			params["nodepool"] = nodepool
+		}
+	}
+
+	if err := validateKubeCreationParameters(params, regions); err != nil {
+		return nil, fmt.Errorf("invalid cluster parameters: %w", err)
+	}
+
+	return params, nil
+}
+
+func runKubeVersionSelector(projectID, region string) (string, error) {
+	const latestVersionChoice = "Latest available version"
+
+	versionChoices := map[string]string{
+		latestVersionChoice: "Let the API select the most recent supported version",
+	}
+
+	endpoint := fmt.Sprintf("/v1/cloud/project/%s/capabilities/kube/versions?region=%s", projectID, url.QueryEscape(region))
+	var versions []string
+	if err := httpLib.Client.Get(endpoint, &versions); err != nil {
+		log.Printf("failed to fetch available Kubernetes versions, only the latest version will be proposed: %s", err)
+	}
+	for _, version := range versions {
+		versionChoices[version] = version
+	}
+
+	selected, _, err := display.RunGenericChoicePicker("Please select a Kubernetes version", versionChoices, 0)
+	if err != nil {
+		return "", err
+	}
+
+	switch selected {
+	case "":
+		return "", errors.New("no version selected, exiting")
+	case latestVersionChoice:
+		return "", nil
+	default:
+		return selected, nil
+	}
+}
+
+func runKubePrivateNetworkSelectors(projectID, region string, params map[string]any) error {
+	const noPrivateNetworkChoice = "No private network"
+
+	var networks []map[string]any
+	if err := httpLib.Client.Get(fmt.Sprintf("/v1/cloud/project/%s/network/private", projectID), &networks); err != nil {
+		return fmt.Errorf("failed to fetch private networks: %w", err)
+	}
+
+	// Only keep networks available in the selected region
+	networkChoices := map[string]string{
+		noPrivateNetworkChoice: "Nodes will only be attached to the public network",
+	}
+	for _, network := range networks {
+		regions, _ := network["regions"].([]any)
+		for _, networkRegion := range regions {
+			networkRegion := networkRegion.(map[string]any)
+			if networkRegion["region"] != region || networkRegion["openstackId"] == nil {
+				continue
+			}
+
+			label := fmt.Sprintf("%s (vlan %v)", network["name"], network["vlanId"])
+			networkChoices[label] = networkRegion["openstackId"].(string)
+		}
+	}
+
+	if len(networkChoices) == 1 {
+		log.Printf("No private network found in region %s, cluster will be created without private network", region)
+		return nil
+	}
+
+	selectedNetwork, openstackID, err := display.RunGenericChoicePicker("Please select a private network", networkChoices, 0)
+	if err != nil {
+		return err
+	}
+	switch selectedNetwork {
+	case "":
+		return errors.New("no private network selected, exiting")
+	case noPrivateNetworkChoice:
+		return nil
+	}
+	params["privateNetworkId"] = openstackID
+
+	// Fetch subnets of the selected network
+	endpoint := fmt.Sprintf("/v1/cloud/project/%s/region/%s/network/%s/subnet", projectID, url.PathEscape(region), url.PathEscape(openstackID))
+	var subnets []map[string]any
+	if err := httpLib.Client.Get(endpoint, &subnets); err != nil {
+		return fmt.Errorf("failed to fetch subnets of private network: %w", err)
+	}
+	if len(subnets) == 0 {
+		return fmt.Errorf("private network %s has no subnet in region %s", selectedNetwork, region)
+	}
+
+	subnetChoices := make(map[string]string, len(subnets))
+	subnetsByID := make(map[string]map[string]any, len(subnets))
+	for _, subnet := range subnets {
+		label := fmt.Sprintf("%s (%s)", subnet["cidr"], subnet["name"])
+		subnetChoices[label] = subnet["id"].(string)
+		subnetsByID[subnet["id"].(string)] = subnet
+	}
+
+	// Select the subnet used by the nodes
+	selectedSubnet, nodesSubnetID, err := display.RunGenericChoicePicker("Please select the subnet used by the nodes", subnetChoices, 0)
+	if err != nil {
+		return err
+	}
+	if selectedSubnet == "" {
+		return errors.New("no subnet selected, exiting")
+	}
+	params["nodesSubnetId"] = nodesSubnetID
+
+	// Select the subnet used by the load balancers
+	const sameSubnetChoice = "Same subnet as the nodes"
+	subnetChoices[sameSubnetChoice] = nodesSubnetID
+	selectedSubnet, lbSubnetID, err := display.RunGenericChoicePicker("Please select the subnet used by the load balancers", subnetChoices, 0)
+	if err != nil {
+		return err
+	}
+	if selectedSubnet == "" {
+		return errors.New("no load balancers subnet selected, exiting")
+	}
+	params["loadBalancersSubnetId"] = lbSubnetID
+
+	// Select the gateway used for egress traffic
+	endpoint = fmt.Sprintf("/v1/cloud/project/%s/region/%s/gateway?subnetId=%s", projectID, url.PathEscape(region), url.QueryEscape(nodesSubnetID))
+	var gateways []map[string]any
+	if err := httpLib.Client.Get(endpoint, &gateways); err != nil {
+		return fmt.Errorf("failed to fetch gateways of subnet: %w", err)
+	}
+
+	const publicEgressChoice = "Use the public network for egress traffic"
+	gatewayChoices := map[string]string{
+		publicEgressChoice: "Nodes will keep a public IP used for egress traffic",
+	}
+	for _, gateway := range gateways {
+		interfaces, _ := gateway["interfaces"].([]any)
+		for _, iface := range interfaces {
+			iface := iface.(map[string]any)
+			if iface["subnetId"] == nodesSubnetID {
+				gatewayChoices[fmt.Sprintf("Gateway %s (%s)", gateway["name"], iface["ip"])] = iface["ip"].(string)
+			}
+		}
+	}
+	if gatewayIP, ok := subnetsByID[nodesSubnetID]["gatewayIp"].(string); ok && gatewayIP != "" {
+		gatewayChoices[fmt.Sprintf("Subnet gateway (%s)", gatewayIP)] = gatewayIP
+	}
+
+	if len(gatewayChoices) == 1 {
+		log.Printf("No gateway found on subnet %s, egress traffic will use the public network", nodesSubnetID)
+		return nil
+	}
+
+	selectedGateway, gatewayIP, err := display.RunGenericChoicePicker("Please select the gateway used for egress traffic", gatewayChoices, 0)
+	if err != nil {
+		return err
+	}
+	switch selectedGateway {
+	case "":
+		return errors.New("no gateway selected, exiting")
+	case publicEgressChoice:
+		return nil
+	}
+
+	params["privateNetworkConfiguration"] = map[string]any{
+		"defaultVrackGateway":            gatewayIP,
+		"privateNetworkRoutingAsDefault": true,
+	}
+
+	return nil
+}
+
+func runKubeNodepoolSelectors(projectID, region string) (map[string]any, error) {
+	const noNodepoolChoice = "No initial node pool"
+
+	flavorChoices, err := getAvailableFlavors(projectID, region)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get available flavors: %w", err)
+	}
+	flavorChoices[noNodepoolChoice] = "Node pools can be added later using 'ovhcloud cloud kube nodepool create'"
+
+	selectedFlavor, _, err := display.RunGenericChoicePicker("Please select the flavor of the initial node pool", flavorChoices, 30)
+	if err != nil {
+		return nil, err
+	}
+	switch selectedFlavor {
+	case "":
+		return nil, errors.New("no flavor selected, exiting")
+	case noNodepoolChoice:
+		return nil, nil
+	}
+
+	nodesChoices := make(map[string]string)
+	for _, count := range []int{1, 2, 3, 5, 10} {
+		nodesChoices[fmt.Sprintf("%2d node(s)", count)] = strconv.Itoa(count)
+	}
+
+	selectedCount, desiredNodes, err := display.RunGenericChoicePicker("Please select the number of nodes of the initial node pool", nodesChoices, 0)
+	if err != nil {
+		return nil, err
+	}
+	if selectedCount == "" {
+		return nil, errors.New("no number of nodes selected, exiting")
+	}
+	nodes, err := strconv.Atoi(desiredNodes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid number of nodes %q: %w", desiredNodes, err)
+	}
+
+	return map[string]any{
+		"flavorName":   selectedFlavor,
+		"desiredNodes": nodes,
+	}, nil
+}
+
+// validateKubeCreationParameters checks the consistency of the parameters
+// produced by the interactive creation wizard.
+func validateKubeCreationParameters(params map[string]any, eligibleRegions []any) error {
+	if !slices.Contains(eligibleRegions, params["region"]) {
+		return fmt.Errorf("region %v is not eligible for Kubernetes clusters", params["region"])
+	}
+
+	_, hasNetwork := params["privateNetworkId"]
+	for _, field := range []string{"nodesSubnetId", "loadBalancersSubnetId", "privateNetworkConfiguration"} {
+		if _, ok := params[field]; ok && !hasNetwork {
+			return fmt.Errorf("field %q requires a private network to be defined", field)
+		}
+	}
+	if _, ok := params["nodesSubnetId"]; hasNetwork && !ok {
+		return errors.New("a nodes subnet must be selected when using a private network")
+	}
+
+	if nodepool, ok := params["nodepool"].(map[string]any); ok {
+		if flavor, _ := nodepool["flavorName"].(string); flavor == "" {
+			return errors.New("initial node pool requires a flavor")
+		}
+		if nodes, _ := nodepool["desiredNodes"].(int); nodes < 1 {
+			return errors.New("initial node pool requires at least one node")
+		}
+	}
+
+	return nil
+}
+
+// mergeKubeSpecWithParameters merges the given parameters into the
+// cluster specification defined using command line flags.
+func mergeKubeSpecWithParameters(params map[string]any) (map[string]any, error) {
+	jsonSpec, err := json.Marshal(KubeSpec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare arguments from command line: %w", err)
+	}
+
+	var spec map[string]any
+	if err := json.Unmarshal(jsonSpec, &spec); err != nil {
+		return nil, fmt.Errorf("failed to parse arguments from command line: %w", err)
+	}
+
+	if err := utils.MergeMaps(spec, params); err != nil {
+		return nil, fmt.Errorf("failed to merge wizard parameters: %w", err)
+	}
+
+	return spec, nil
+}
+
 func GetKubeOIDCIntegration(_ *cobra.Command, args []string) {
 	projectID, err := getConfiguredCloudProject()
 	if err != nil {
@@ -52,6 +52,126 @@ func locateLoadbalancer(projectID, loadbalancerID string) (string, map[string]an
 	return "", nil, fmt.Errorf("no loadbalancer found with id %s", loadbalancerID)
 }
 
+// loadbalancingEndpoint returns the base endpoint of the loadbalancing
+// resources located in the region of the given loadbalancer
+func loadbalancingEndpoint(projectID, loadbalancerID string) (string, error) {
+	region, _, err := locateLoadbalancer(projectID, loadbalancerID)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("/v1/cloud/project/%s/region/%s/loadbalancing", projectID, url.PathEscape(region)), nil
+}
+
+// listLoadbalancerResources displays the loadbalancing resources of the given kind
+// that belong to the given loadbalancer
+func listLoadbalancerResources(loadbalancerID, resource string, columnsToDisplay []string) {
+	projectID, err := getConfiguredCloudProject()
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	endpoint, err := loadbalancingEndpoint(projectID, loadbalancerID)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	common.ManageListRequestNoExpand(
+		fmt.Sprintf("%s/%s?loadbalancerId=%s", endpoint, resource, url.QueryEscape(loadbalancerID)),
+		columnsToDisplay,
+		flags.GenericFilters,
+	)
+}
+
+// getLoadbalancerResource displays the given loadbalancing resource
+func getLoadbalancerResource(loadbalancerID, resource, resourceID string) {
+	projectID, err := getConfiguredCloudProject()
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	endpoint, err := loadbalancingEndpoint(projectID, loadbalancerID)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	common.ManageObjectRequest(endpoint+"/"+resource, resourceID, "")
+}
+
+// createLoadbalancerResource creates a loadbalancing resource of the given kind
+// in the region of the given loadbalancer and returns its ID
+func createLoadbalancerResource(loadbalancerID, resource string, body any) (string, error) {
+	projectID, err := getConfiguredCloudProject()
+	if err != nil {
+		return "", err
+	}
+
+	endpoint, err := loadbalancingEndpoint(projectID, loadbalancerID)
+	if err != nil {
+		return "", err
+	}
+
+	var created map[string]any
+	if err := httpLib.Client.Post(endpoint+"/"+resource, body, &created); err != nil {
+		return "", err
+	}
+
+	return fmt.Sprint(created["id"]), nil
+}
+
+// editLoadbalancerResource updates the given loadbalancing resource with the
+// parameters given on command line
+func editLoadbalancerResource(cmd *cobra.Command, loadbalancerID, resource, resourceID, schemaPath string, cliParams any) {
+	projectID, err := getConfiguredCloudProject()
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	endpoint, err := loadbalancingEndpoint(projectID, loadbalancerID)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	if err := common.EditResource(
+		cmd,
+		"/cloud/project/{serviceName}/region/{regionName}/loadbalancing/"+schemaPath,
+		fmt.Sprintf("%s/%s/%s", endpoint, resource, url.PathEscape(resourceID)),
+		cliParams,
+		assets.CloudOpenapiSchema,
+	); err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+}
+
+// deleteLoadbalancerResource deletes the given loadbalancing resource
+func deleteLoadbalancerResource(loadbalancerID, resource, resourceID, kind string) {
+	projectID, err := getConfiguredCloudProject()
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	endpoint, err := loadbalancingEndpoint(projectID, loadbalancerID)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	if err := httpLib.Client.Delete(fmt.Sprintf("%s/%s/%s", endpoint, resource, url.PathEscape(resourceID)), nil); err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to delete %s %s: %s", kind, resourceID, err)
+		return
+	}
+
+	display.OutputInfo(&flags.OutputFormatConfig, nil, "✅ %s %s deleted successfully", kind, resourceID)
+}
+
 func ListCloudLoadbalancers(_ *cobra.Command, _ []string) {
 	projectID, err := getConfiguredCloudProject()
 	if err != nil {
@@ -0,0 +1,127 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cloud
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+
+	"github.com/ovh/ovhcloud-cli/internal/display"
+	filtersLib "github.com/ovh/ovhcloud-cli/internal/filters"
+	"github.com/ovh/ovhcloud-cli/internal/flags"
+	httpLib "github.com/ovh/ovhcloud-cli/internal/http"
+	"github.com/spf13/cobra"
+)
+
+var (
+	cloudLoadbalancerHealthMonitorColumnsToDisplay = []string{"id", "name", "poolId", "monitorType", "delay", "timeout", "maxRetries", "operatingStatus"}
+
+	// CloudLoadbalancerHealthMonitorTypes is the list of health monitor types
+	CloudLoadbalancerHealthMonitorTypes = []string{"http", "https", "ping", "sctp", "tcp", "tls-hello", "udp-connect"}
+
+	// CloudLoadbalancerHealthMonitorSpec holds the parameters of the health monitor to create.
+	CloudLoadbalancerHealthMonitorSpec struct {
+		Name              string                                  `json:"name,omitempty"`
+		PoolID            string                                  `json:"poolId"`
+		MonitorType       string                                  `json:"monitorType"`
+		Delay             int                                     `json:"delay"`
+		Timeout           int                                     `json:"timeout"`
+		MaxRetries        int                                     `json:"maxRetries"`
+		MaxRetriesDown    int                                     `json:"maxRetriesDown,omitempty"`
+		HTTPConfiguration *CloudLoadbalancerHealthMonitorHTTPSpec `json:"httpConfiguration,omitempty"`
+	}
+
+	// CloudLoadbalancerHealthMonitorHTTP holds the HTTP parameters of the health monitor to create.
+	CloudLoadbalancerHealthMonitorHTTP CloudLoadbalancerHealthMonitorHTTPSpec
+
+	// CloudLoadbalancerHealthMonitorUpdateSpec holds the parameters of the health monitor to update.
+	CloudLoadbalancerHealthMonitorUpdateSpec struct {
+		Name           string `json:"name,omitempty"`
+		Delay          int    `json:"delay,omitempty"`
+		Timeout        int    `json:"timeout,omitempty"`
+		MaxRetries     int    `json:"maxRetries,omitempty"`
+		MaxRetriesDown int    `json:"maxRetriesDown,omitempty"`
+	}
+)
+
+// CloudLoadbalancerHealthMonitorHTTPSpec is the HTTP configuration of a health monitor
+type CloudLoadbalancerHealthMonitorHTTPSpec struct {
+	URLPath       string `json:"urlPath,omitempty"`
+	HTTPMethod    string `json:"httpMethod,omitempty"`
+	ExpectedCodes string `json:"expectedCodes,omitempty"`
+}
+
+func ListCloudLoadbalancerHealthMonitors(_ *cobra.Command, args []string) {
+	projectID, err := getConfiguredCloudProject()
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	endpoint, err := loadbalancingEndpoint(projectID, args[0])
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	// Health monitors are attached to pools, keep only the ones of the loadbalancer pools
+	var pools []struct {
+		ID string `json:"id"`
+	}
+	if err := httpLib.Client.Get(fmt.Sprintf("%s/pool?loadbalancerId=%s", endpoint, url.QueryEscape(args[0])), &pools); err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to fetch pools: %s", err)
+		return
+	}
+	var poolIDs []string
+	for _, pool := range pools {
+		poolIDs = append(poolIDs, pool.ID)
+	}
+
+	var monitors []map[string]any
+	if err := httpLib.Client.Get(endpoint+"/healthMonitor", &monitors); err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to fetch health monitors: %s", err)
+		return
+	}
+	monitors = slices.DeleteFunc(monitors, func(monitor map[string]any) bool {
+		poolID, _ := monitor["poolId"].(string)
+		return !slices.Contains(poolIDs, poolID)
+	})
+
+	monitors, err = filtersLib.FilterLines(monitors, flags.GenericFilters)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to filter results: %s", err)
+		return
+	}
+
+	display.RenderTable(monitors, cloudLoadbalancerHealthMonitorColumnsToDisplay, &flags.OutputFormatConfig)
+}
+
+func GetCloudLoadbalancerHealthMonitor(_ *cobra.Command, args []string) {
+	getLoadbalancerResource(args[0], "healthMonitor", args[1])
+}
+
+func CreateCloudLoadbalancerHealthMonitor(_ *cobra.Command, args []string) {
+	CloudLoadbalancerHealthMonitorSpec.PoolID = args[1]
+	if CloudLoadbalancerHealthMonitorHTTP != (CloudLoadbalancerHealthMonitorHTTPSpec{}) {
+		CloudLoadbalancerHealthMonitorSpec.HTTPConfiguration = &CloudLoadbalancerHealthMonitorHTTP
+	}
+
+	monitorID, err := createLoadbalancerResource(args[0], "healthMonitor", CloudLoadbalancerHealthMonitorSpec)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to create health monitor: %s", err)
+		return
+	}
+
+	display.OutputInfo(&flags.OutputFormatConfig, map[string]any{"id": monitorID}, "✅ Health monitor %s created successfully on pool %s", monitorID, args[1])
+}
+
+func EditCloudLoadbalancerHealthMonitor(cmd *cobra.Command, args []string) {
+	editLoadbalancerResource(cmd, args[0], "healthMonitor", args[1], "healthMonitor/{healthMonitorId}", CloudLoadbalancerHealthMonitorUpdateSpec)
+}
+
+func DeleteCloudLoadbalancerHealthMonitor(_ *cobra.Command, args []string) {
+	deleteLoadbalancerResource(args[0], "healthMonitor", args[1], "Health monitor")
+}
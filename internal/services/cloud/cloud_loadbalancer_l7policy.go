@@ -0,0 +1,87 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cloud
+
+import (
+	"github.com/ovh/ovhcloud-cli/internal/display"
+	"github.com/ovh/ovhcloud-cli/internal/flags"
+	"github.com/spf13/cobra"
+)
+
+var (
+	cloudLoadbalancerL7PolicyColumnsToDisplay = []string{"id", "name", "listenerId", "action", "position", "provisioningStatus", "operatingStatus"}
+
+	// CloudLoadbalancerL7PolicyActions is the list of actions available for L7 policies
+	CloudLoadbalancerL7PolicyActions = []string{"redirectPrefix", "redirectToPool", "redirectToURL", "reject"}
+
+	// CloudLoadbalancerL7PolicySpec holds the parameters of the L7 policy to create.
+	CloudLoadbalancerL7PolicySpec struct {
+		Name             string `json:"name,omitempty"`
+		ListenerID       string `json:"listenerId"`
+		Action           string `json:"action"`
+		Position         int    `json:"position,omitempty"`
+		RedirectPoolID   string `json:"redirectPoolId,omitempty"`
+		RedirectURL      string `json:"redirectUrl,omitempty"`
+		RedirectPrefix   string `json:"redirectPrefix,omitempty"`
+		RedirectHTTPCode int    `json:"redirectHttpCode,omitempty"`
+	}
+
+	// CloudLoadbalancerL7PolicyUpdateSpec holds the parameters of the L7 policy to update.
+	CloudLoadbalancerL7PolicyUpdateSpec struct {
+		Name             string `json:"name,omitempty"`
+		Action           string `json:"action,omitempty"`
+		Position         int    `json:"position,omitempty"`
+		RedirectPoolID   string `json:"redirectPoolId,omitempty"`
+		RedirectURL      string `json:"redirectUrl,omitempty"`
+		RedirectPrefix   string `json:"redirectPrefix,omitempty"`
+		RedirectHTTPCode int    `json:"redirectHttpCode,omitempty"`
+	}
+)
+
+func ListCloudLoadbalancerL7Policies(_ *cobra.Command, args []string) {
+	listLoadbalancerResources(args[0], "l7Policy", cloudLoadbalancerL7PolicyColumnsToDisplay)
+}
+
+func GetCloudLoadbalancerL7Policy(_ *cobra.Command, args []string) {
+	getLoadbalancerResource(args[0], "l7Policy", args[1])
+}
+
+func CreateCloudLoadbalancerL7Policy(_ *cobra.Command, args []string) {
+	CloudLoadbalancerL7PolicySpec.ListenerID = args[1]
+
+	switch CloudLoadbalancerL7PolicySpec.Action {
+	case "redirectToPool":
+		if CloudLoadbalancerL7PolicySpec.RedirectPoolID == "" {
+			display.OutputError(&flags.OutputFormatConfig, "--redirect-pool is required for action redirectToPool")
+			return
+		}
+	case "redirectToURL":
+		if CloudLoadbalancerL7PolicySpec.RedirectURL == "" {
+			display.OutputError(&flags.OutputFormatConfig, "--redirect-url is required for action redirectToURL")
+			return
+		}
+	case "redirectPrefix":
+		if CloudLoadbalancerL7PolicySpec.RedirectPrefix == "" {
+			display.OutputError(&flags.OutputFormatConfig, "--redirect-prefix is required for action redirectPrefix")
+			return
+		}
+	}
+
+	policyID, err := createLoadbalancerResource(args[0], "l7Policy", CloudLoadbalancerL7PolicySpec)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to create L7 policy: %s", err)
+		return
+	}
+
+	display.OutputInfo(&flags.OutputFormatConfig, map[string]any{"id": policyID}, "✅ L7 policy %s created successfully on listener %s", policyID, args[1])
+}
+
+func EditCloudLoadbalancerL7Policy(cmd *cobra.Command, args []string) {
+	editLoadbalancerResource(cmd, args[0], "l7Policy", args[1], "l7Policy/{l7PolicyId}", CloudLoadbalancerL7PolicyUpdateSpec)
+}
+
+func DeleteCloudLoadbalancerL7Policy(_ *cobra.Command, args []string) {
+	deleteLoadbalancerResource(args[0], "l7Policy", args[1], "L7 policy")
+}
@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cloud
+
+import (
+	"github.com/ovh/ovhcloud-cli/internal/display"
+	"github.com/ovh/ovhcloud-cli/internal/flags"
+	"github.com/spf13/cobra"
+)
+
+var (
+	cloudLoadbalancerListenerColumnsToDisplay = []string{"id", "name", "protocol", "port", "defaultPoolId", "provisioningStatus", "operatingStatus"}
+
+	// CloudLoadbalancerListenerProtocols is the list of protocols available for listeners
+	CloudLoadbalancerListenerProtocols = []string{"http", "https", "prometheus", "sctp", "tcp", "terminatedHTTPS", "udp"}
+
+	// CloudLoadbalancerListenerSpec holds the parameters of the listener to create.
+	CloudLoadbalancerListenerSpec struct {
+		Name           string `json:"name,omitempty"`
+		Description    string `json:"description,omitempty"`
+		Protocol       string `json:"protocol"`
+		Port           int    `json:"port"`
+		DefaultPoolID  string `json:"defaultPoolId,omitempty"`
+		LoadbalancerID string `json:"loadbalancerId"`
+	}
+
+	// CloudLoadbalancerListenerUpdateSpec holds the parameters of the listener to update.
+	CloudLoadbalancerListenerUpdateSpec struct {
+		Name          string `json:"name,omitempty"`
+		Description   string `json:"description,omitempty"`
+		DefaultPoolID string `json:"defaultPoolId,omitempty"`
+	}
+)
+
+func ListCloudLoadbalancerListeners(_ *cobra.Command, args []string) {
+	listLoadbalancerResources(args[0], "listener", cloudLoadbalancerListenerColumnsToDisplay)
+}
+
+func GetCloudLoadbalancerListener(_ *cobra.Command, args []string) {
+	getLoadbalancerResource(args[0], "listener", args[1])
+}
+
+func CreateCloudLoadbalancerListener(_ *cobra.Command, args []string) {
+	CloudLoadbalancerListenerSpec.LoadbalancerID = args[0]
+
+	listenerID, err := createLoadbalancerResource(args[0], "listener", CloudLoadbalancerListenerSpec)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to create listener: %s", err)
+		return
+	}
+
+	display.OutputInfo(&flags.OutputFormatConfig, map[string]any{"id": listenerID},
+		"✅ Listener %s created successfully on port %d", listenerID, CloudLoadbalancerListenerSpec.Port)
+}
+
+func EditCloudLoadbalancerListener(cmd *cobra.Command, args []string) {
+	editLoadbalancerResource(cmd, args[0], "listener", args[1], "listener/{listenerId}", CloudLoadbalancerListenerUpdateSpec)
+}
+
+func DeleteCloudLoadbalancerListener(_ *cobra.Command, args []string) {
+	deleteLoadbalancerResource(args[0], "listener", args[1], "Listener")
+}
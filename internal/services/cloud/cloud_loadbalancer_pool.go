@@ -0,0 +1,199 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cloud
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/ovh/ovhcloud-cli/internal/display"
+	"github.com/ovh/ovhcloud-cli/internal/flags"
+	httpLib "github.com/ovh/ovhcloud-cli/internal/http"
+	"github.com/ovh/ovhcloud-cli/internal/services/common"
+	"github.com/spf13/cobra"
+)
+
+var (
+	cloudLoadbalancerPoolColumnsToDisplay   = []string{"id", "name", "protocol", "algorithm", "provisioningStatus", "operatingStatus"}
+	cloudLoadbalancerMemberColumnsToDisplay = []string{"id", "name", "address", "protocolPort", "weight", "provisioningStatus", "operatingStatus"}
+
+	// CloudLoadbalancerPoolProtocols is the list of protocols available for pools
+	CloudLoadbalancerPoolProtocols = []string{"http", "https", "proxy", "proxyV2", "sctp", "tcp", "udp"}
+
+	// CloudLoadbalancerPoolAlgorithms is the list of load balancing algorithms available for pools
+	CloudLoadbalancerPoolAlgorithms = []string{"leastConnections", "roundRobin", "sourceIP"}
+
+	// CloudLoadbalancerPoolSpec holds the parameters of the pool to create.
+	CloudLoadbalancerPoolSpec struct {
+		Name           string `json:"name,omitempty"`
+		Protocol       string `json:"protocol"`
+		Algorithm      string `json:"algorithm"`
+		ListenerID     string `json:"listenerId,omitempty"`
+		LoadbalancerID string `json:"loadbalancerId"`
+	}
+
+	// CloudLoadbalancerPoolUpdateSpec holds the parameters of the pool to update.
+	CloudLoadbalancerPoolUpdateSpec struct {
+		Name      string `json:"name,omitempty"`
+		Algorithm string `json:"algorithm,omitempty"`
+	}
+
+	// CloudLoadbalancerMemberSpec holds the parameters of the pool member to add.
+	CloudLoadbalancerMemberSpec struct {
+		Name         string `json:"name,omitempty"`
+		Address      string `json:"address"`
+		ProtocolPort int    `json:"protocolPort"`
+		Weight       *int   `json:"weight,omitempty"`
+	}
+
+	// CloudLoadbalancerMemberUpdateSpec holds the parameters of the pool member to update.
+	CloudLoadbalancerMemberUpdateSpec struct {
+		Name   string `json:"name,omitempty"`
+		Weight *int   `json:"weight,omitempty"`
+	}
+
+	// CloudLoadbalancerMemberInstanceIP is the private IP of the instance to add to the pool.
+	CloudLoadbalancerMemberInstanceIP string
+)
+
+func ListCloudLoadbalancerPools(_ *cobra.Command, args []string) {
+	listLoadbalancerResources(args[0], "pool", cloudLoadbalancerPoolColumnsToDisplay)
+}
+
+func GetCloudLoadbalancerPool(_ *cobra.Command, args []string) {
+	getLoadbalancerResource(args[0], "pool", args[1])
+}
+
+func CreateCloudLoadbalancerPool(_ *cobra.Command, args []string) {
+	CloudLoadbalancerPoolSpec.LoadbalancerID = args[0]
+
+	poolID, err := createLoadbalancerResource(args[0], "pool", CloudLoadbalancerPoolSpec)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to create pool: %s", err)
+		return
+	}
+
+	display.OutputInfo(&flags.OutputFormatConfig, map[string]any{"id": poolID}, "✅ Pool %s created successfully", poolID)
+}
+
+func EditCloudLoadbalancerPool(cmd *cobra.Command, args []string) {
+	editLoadbalancerResource(cmd, args[0], "pool", args[1], "pool/{poolId}", CloudLoadbalancerPoolUpdateSpec)
+}
+
+func DeleteCloudLoadbalancerPool(_ *cobra.Command, args []string) {
+	deleteLoadbalancerResource(args[0], "pool", args[1], "Pool")
+}
+
+func ListCloudLoadbalancerMembers(_ *cobra.Command, args []string) {
+	projectID, err := getConfiguredCloudProject()
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	endpoint, err := loadbalancingEndpoint(projectID, args[0])
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	common.ManageListRequestNoExpand(
+		fmt.Sprintf("%s/pool/%s/member", endpoint, url.PathEscape(args[1])),
+		cloudLoadbalancerMemberColumnsToDisplay,
+		flags.GenericFilters,
+	)
+}
+
+func GetCloudLoadbalancerMember(_ *cobra.Command, args []string) {
+	getLoadbalancerResource(args[0], "pool/"+url.PathEscape(args[1])+"/member", args[2])
+}
+
+// addLoadbalancerPoolMember adds a member to the given pool and returns its ID
+func addLoadbalancerPoolMember(endpoint, poolID string) (string, error) {
+	var members []map[string]any
+	if err := httpLib.Client.Post(
+		fmt.Sprintf("%s/pool/%s/member", endpoint, url.PathEscape(poolID)),
+		map[string]any{"members": []any{CloudLoadbalancerMemberSpec}},
+		&members,
+	); err != nil {
+		return "", err
+	}
+
+	if len(members) == 0 {
+		return "", nil
+	}
+
+	return fmt.Sprint(members[0]["id"]), nil
+}
+
+func AddCloudLoadbalancerMember(_ *cobra.Command, args []string) {
+	projectID, err := getConfiguredCloudProject()
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	endpoint, err := loadbalancingEndpoint(projectID, args[0])
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	memberID, err := addLoadbalancerPoolMember(endpoint, args[1])
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to add member to pool %s: %s", args[1], err)
+		return
+	}
+
+	display.OutputInfo(&flags.OutputFormatConfig, map[string]any{"id": memberID},
+		"✅ Member %s:%d added to pool %s", CloudLoadbalancerMemberSpec.Address, CloudLoadbalancerMemberSpec.ProtocolPort, args[1])
+}
+
+func AddCloudLoadbalancerInstanceMember(_ *cobra.Command, args []string) {
+	projectID, err := getConfiguredCloudProject()
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	region, _, err := locateLoadbalancer(projectID, args[0])
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	instanceRegion, privateIP, err := getInstancePrivateIP(projectID, args[2], CloudLoadbalancerMemberInstanceIP)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+	if instanceRegion != region {
+		display.OutputError(&flags.OutputFormatConfig, "instance %s is in region %s while loadbalancer %s is in region %s", args[2], instanceRegion, args[0], region)
+		return
+	}
+
+	CloudLoadbalancerMemberSpec.Address = privateIP
+	if CloudLoadbalancerMemberSpec.Name == "" {
+		CloudLoadbalancerMemberSpec.Name = args[2]
+	}
+
+	endpoint := fmt.Sprintf("/v1/cloud/project/%s/region/%s/loadbalancing", projectID, url.PathEscape(region))
+	memberID, err := addLoadbalancerPoolMember(endpoint, args[1])
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to add instance %s to pool %s: %s", args[2], args[1], err)
+		return
+	}
+
+	display.OutputInfo(&flags.OutputFormatConfig, map[string]any{"id": memberID, "address": privateIP},
+		"✅ Instance %s (%s:%d) added to pool %s", args[2], privateIP, CloudLoadbalancerMemberSpec.ProtocolPort, args[1])
+}
+
+func EditCloudLoadbalancerMember(cmd *cobra.Command, args []string) {
+	editLoadbalancerResource(cmd, args[0], "pool/"+url.PathEscape(args[1])+"/member", args[2], "pool/{poolId}/member/{memberId}", CloudLoadbalancerMemberUpdateSpec)
+}
+
+func DeleteCloudLoadbalancerMember(_ *cobra.Command, args []string) {
+	deleteLoadbalancerResource(args[0], "pool/"+url.PathEscape(args[1])+"/member", args[2], "Member")
+}
@@ -0,0 +1,251 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cloud
+
+import (
+	_ "embed"
+	"fmt"
+	"net/url"
+
+	"github.com/ovh/ovhcloud-cli/internal/display"
+	filtersLib "github.com/ovh/ovhcloud-cli/internal/filters"
+	"github.com/ovh/ovhcloud-cli/internal/flags"
+	httpLib "github.com/ovh/ovhcloud-cli/internal/http"
+	"github.com/spf13/cobra"
+)
+
+var (
+	cloudprojectFloatingIPColumnsToDisplay = []string{"id", "ip", "region", "status", "associatedEntity.id", "associatedEntity.ip"}
+
+	//go:embed templates/cloud_network_floating_ip.tmpl
+	cloudFloatingIPTemplate string
+
+	// FloatingIPInstanceIP is the private IP of the instance to associate a floating IP with.
+	FloatingIPInstanceIP string
+)
+
+// fetchFloatingIPs returns the floating IPs of all the regions of the project
+func fetchFloatingIPs(projectID string) ([]map[string]any, error) {
+	// Fetch regions with network feature available
+	regions, err := getCloudRegionsWithFeatureAvailable(projectID, "network")
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch regions with network feature available: %w", err)
+	}
+
+	// Fetch floating IPs in all regions
+	url := fmt.Sprintf("/v1/cloud/project/%s/region", projectID)
+	floatingIPs, err := httpLib.FetchObjectsParallel[[]map[string]any](url+"/%s/floatingip", regions, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch floating IPs: %w", err)
+	}
+
+	// Flatten floating IPs in a single array
+	var allFloatingIPs []map[string]any
+	for _, regionFloatingIPs := range floatingIPs {
+		allFloatingIPs = append(allFloatingIPs, regionFloatingIPs...)
+	}
+
+	return allFloatingIPs, nil
+}
+
+// findFloatingIP returns the endpoint and the details of the floating IP
+// with the given ID or public IP address
+func findFloatingIP(projectID, idOrIP string) (string, map[string]any, error) {
+	floatingIPs, err := fetchFloatingIPs(projectID)
+	if err != nil {
+		return "", nil, err
+	}
+
+	for _, floatingIP := range floatingIPs {
+		if floatingIP["id"] == idOrIP || floatingIP["ip"] == idOrIP {
+			endpoint := fmt.Sprintf("/v1/cloud/project/%s/region/%s/floatingip/%s",
+				projectID, url.PathEscape(floatingIP["region"].(string)), url.PathEscape(floatingIP["id"].(string)))
+			return endpoint, floatingIP, nil
+		}
+	}
+
+	return "", nil, fmt.Errorf("no floating IP found with ID or address %q", idOrIP)
+}
+
+// getInstancePrivateIP returns the region of the given instance and its private IP,
+// forcedIP if set or the first private IPv4 address of the instance otherwise
+func getInstancePrivateIP(projectID, instanceID, forcedIP string) (string, string, error) {
+	var instance struct {
+		Region      string `json:"region"`
+		IPAddresses []struct {
+			IP      string `json:"ip"`
+			Type    string `json:"type"`
+			Version int    `json:"version"`
+		} `json:"ipAddresses"`
+	}
+	if err := httpLib.Client.Get(fmt.Sprintf("/v1/cloud/project/%s/instance/%s", projectID, url.PathEscape(instanceID)), &instance); err != nil {
+		return "", "", fmt.Errorf("failed to fetch instance details: %w", err)
+	}
+
+	if forcedIP != "" {
+		return instance.Region, forcedIP, nil
+	}
+
+	for _, ip := range instance.IPAddresses {
+		if ip.Type == "private" && ip.Version == 4 {
+			return instance.Region, ip.IP, nil
+		}
+	}
+
+	return "", "", fmt.Errorf("instance %s has no private IPv4 address, it must be attached to a private network", instanceID)
+}
+
+func ListFloatingIPs(_ *cobra.Command, _ []string) {
+	projectID, err := getConfiguredCloudProject()
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	floatingIPs, err := fetchFloatingIPs(projectID)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	// Filter results
+	floatingIPs, err = filtersLib.FilterLines(floatingIPs, flags.GenericFilters)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to filter results: %s", err)
+		return
+	}
+
+	display.RenderTable(floatingIPs, cloudprojectFloatingIPColumnsToDisplay, &flags.OutputFormatConfig)
+}
+
+func GetFloatingIP(_ *cobra.Command, args []string) {
+	projectID, err := getConfiguredCloudProject()
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	_, floatingIP, err := findFloatingIP(projectID, args[0])
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	display.OutputObject(floatingIP, args[0], cloudFloatingIPTemplate, &flags.OutputFormatConfig)
+}
+
+func CreateFloatingIP(_ *cobra.Command, args []string) {
+	projectID, err := getConfiguredCloudProject()
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	region, privateIP, err := getInstancePrivateIP(projectID, args[0], FloatingIPInstanceIP)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	endpoint := fmt.Sprintf("/v1/cloud/project/%s/region/%s/instance/%s/floatingIp", projectID, url.PathEscape(region), url.PathEscape(args[0]))
+	var floatingIP map[string]any
+	if err := httpLib.Client.Post(endpoint, map[string]any{"ip": privateIP}, &floatingIP); err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to create floating IP: %s", err)
+		return
+	}
+
+	display.OutputInfo(&flags.OutputFormatConfig, floatingIP, "✅ Floating IP %s created and associated with instance %s", floatingIP["ip"], args[0])
+}
+
+func AttachFloatingIP(_ *cobra.Command, args []string) {
+	projectID, err := getConfiguredCloudProject()
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	foundURL, floatingIP, err := findFloatingIP(projectID, args[0])
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	region, privateIP, err := getInstancePrivateIP(projectID, args[1], FloatingIPInstanceIP)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+	if region != floatingIP["region"] {
+		display.OutputError(&flags.OutputFormatConfig, "floating IP is in region %s while instance is in region %s", floatingIP["region"], region)
+		return
+	}
+
+	// A floating IP must be detached before being associated with another instance
+	if associated, ok := floatingIP["associatedEntity"].(map[string]any); ok && associated["id"] != nil {
+		if associated["id"] == args[1] {
+			display.OutputInfo(&flags.OutputFormatConfig, nil, "✅ Floating IP %s is already associated with instance %s", floatingIP["ip"], args[1])
+			return
+		}
+
+		if err := httpLib.Client.Post(foundURL+"/detach", nil, nil); err != nil {
+			display.OutputError(&flags.OutputFormatConfig, "failed to detach floating IP from %s: %s", associated["id"], err)
+			return
+		}
+	}
+
+	endpoint := fmt.Sprintf("/v1/cloud/project/%s/region/%s/instance/%s/associateFloatingIp", projectID, url.PathEscape(region), url.PathEscape(args[1]))
+	body := map[string]any{
+		"floatingIpId": floatingIP["id"],
+		"ip":           privateIP,
+	}
+	if err := httpLib.Client.Post(endpoint, body, nil); err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to associate floating IP: %s", err)
+		return
+	}
+
+	display.OutputInfo(&flags.OutputFormatConfig, nil, "✅ Floating IP %s associated with instance %s", floatingIP["ip"], args[1])
+}
+
+func DetachFloatingIP(_ *cobra.Command, args []string) {
+	projectID, err := getConfiguredCloudProject()
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	foundURL, floatingIP, err := findFloatingIP(projectID, args[0])
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	if err := httpLib.Client.Post(foundURL+"/detach", nil, nil); err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to detach floating IP: %s", err)
+		return
+	}
+
+	display.OutputInfo(&flags.OutputFormatConfig, nil, "✅ Floating IP %s detached successfully", floatingIP["ip"])
+}
+
+func DeleteFloatingIP(_ *cobra.Command, args []string) {
+	projectID, err := getConfiguredCloudProject()
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	foundURL, floatingIP, err := findFloatingIP(projectID, args[0])
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	if err := httpLib.Client.Delete(foundURL, nil); err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to delete floating IP: %s", err)
+		return
+	}
+
+	display.OutputInfo(&flags.OutputFormatConfig, nil, "✅ Floating IP %s deleted successfully", floatingIP["ip"])
+}
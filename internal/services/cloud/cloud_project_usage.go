@@ -0,0 +1,411 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cloud
+
+import (
+	"cmp"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"math"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ovh/ovhcloud-cli/internal/display"
+	filtersLib "github.com/ovh/ovhcloud-cli/internal/filters"
+	"github.com/ovh/ovhcloud-cli/internal/flags"
+	httpLib "github.com/ovh/ovhcloud-cli/internal/http"
+	"github.com/ovh/ovhcloud-cli/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+var (
+	cloudProjectUsageColumnsToDisplay = []string{"period", "billing", "resource", "region", "reference", "resourceId", "quantity", "unit", "price"}
+
+	// CloudProjectUsageGroupings is the list of keys usage lines can be grouped by
+	CloudProjectUsageGroupings = []string{"resource", "region", "instance"}
+
+	// CloudProjectUsageParams holds the parameters of the usage reports.
+	CloudProjectUsageParams struct {
+		Since   string
+		Until   string
+		GroupBy string
+		CSV     bool
+	}
+)
+
+type projectUsageQuantity struct {
+	Unit  string  `json:"unit"`
+	Value float64 `json:"value"`
+}
+
+type projectUsageDetail struct {
+	Quantity   projectUsageQuantity `json:"quantity"`
+	TotalPrice float64              `json:"totalPrice"`
+}
+
+type projectUsageItem struct {
+	Region     string               `json:"region"`
+	Reference  string               `json:"reference"`
+	Quantity   projectUsageQuantity `json:"quantity"`
+	TotalPrice float64              `json:"totalPrice"`
+	Details    []json.RawMessage    `json:"details"`
+}
+
+type projectUsage struct {
+	Period struct {
+		From string `json:"from"`
+		To   string `json:"to"`
+	} `json:"period"`
+	HourlyUsage  map[string]json.RawMessage `json:"hourlyUsage"`
+	MonthlyUsage map[string]json.RawMessage `json:"monthlyUsage"`
+}
+
+// usageDetailResourceID returns the ID of the resource a usage detail
+// is about (instanceId, volumeId…), or its name when it has no ID
+func usageDetailResourceID(detail map[string]any) string {
+	keys := make([]string, 0, len(detail))
+	for key := range detail {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	for _, suffix := range []string{"Id", "Name"} {
+		for _, key := range keys {
+			if value, ok := detail[key].(string); ok && strings.HasSuffix(key, suffix) {
+				return value
+			}
+		}
+	}
+
+	return ""
+}
+
+// flattenProjectUsage converts the given usage in a list of lines, one per
+// resource when the details are available, or one per usage item otherwise
+func flattenProjectUsage(usage projectUsage) ([]map[string]any, error) {
+	var (
+		lines  []map[string]any
+		period = usage.Period.From
+	)
+
+	for _, billing := range []struct {
+		name  string
+		usage map[string]json.RawMessage
+	}{
+		{"hourly", usage.HourlyUsage},
+		{"monthly", usage.MonthlyUsage},
+	} {
+		resources := make([]string, 0, len(billing.usage))
+		for resource := range billing.usage {
+			resources = append(resources, resource)
+		}
+		slices.Sort(resources)
+
+		for _, resource := range resources {
+			// Only lists of usage items are reported, other fields are metadata
+			var items []projectUsageItem
+			if err := json.Unmarshal(billing.usage[resource], &items); err != nil {
+				continue
+			}
+
+			for _, item := range items {
+				line := map[string]any{
+					"period":    period,
+					"billing":   billing.name,
+					"resource":  resource,
+					"region":    item.Region,
+					"reference": item.Reference,
+				}
+
+				if len(item.Details) == 0 {
+					line["resourceId"] = ""
+					line["quantity"] = item.Quantity.Value
+					line["unit"] = item.Quantity.Unit
+					line["price"] = item.TotalPrice
+					lines = append(lines, line)
+					continue
+				}
+
+				for _, rawDetail := range item.Details {
+					var (
+						detail    projectUsageDetail
+						detailMap map[string]any
+					)
+					if err := json.Unmarshal(rawDetail, &detail); err != nil {
+						return nil, fmt.Errorf("invalid %s usage detail: %w", resource, err)
+					}
+					if err := json.Unmarshal(rawDetail, &detailMap); err != nil {
+						return nil, fmt.Errorf("invalid %s usage detail: %w", resource, err)
+					}
+
+					detailLine := maps.Clone(line)
+					detailLine["resourceId"] = usageDetailResourceID(detailMap)
+					detailLine["quantity"] = detail.Quantity.Value
+					detailLine["unit"] = detail.Quantity.Unit
+					detailLine["price"] = detail.TotalPrice
+					lines = append(lines, detailLine)
+				}
+			}
+		}
+	}
+
+	return lines, nil
+}
+
+// roundPrice rounds the given price to the cent
+func roundPrice(price float64) float64 {
+	return math.Round(price*100) / 100
+}
+
+// summarizeProjectUsage groups the given usage lines by the given key, and
+// appends a line with the total price
+func summarizeProjectUsage(lines []map[string]any, groupBy string) ([]map[string]any, []string) {
+	var total float64
+	if groupBy == "" {
+		for _, line := range lines {
+			total += line["price"].(float64)
+		}
+		return append(lines, map[string]any{"resource": "total", "price": roundPrice(total)}), cloudProjectUsageColumnsToDisplay
+	}
+
+	key := groupBy
+	if groupBy == "instance" {
+		key = "resourceId"
+	}
+
+	var (
+		groups  []string
+		grouped = make(map[string]map[string]any)
+	)
+	for _, line := range lines {
+		if groupBy == "instance" && line["resource"] != "instance" {
+			continue
+		}
+
+		value := fmt.Sprint(line[key])
+		group, ok := grouped[value]
+		if !ok {
+			group = map[string]any{groupBy: value, "lines": 0, "price": 0.0}
+			grouped[value] = group
+			groups = append(groups, value)
+		}
+		group["lines"] = group["lines"].(int) + 1
+		group["price"] = group["price"].(float64) + line["price"].(float64)
+		total += line["price"].(float64)
+	}
+
+	// Most expensive groups first
+	slices.SortStableFunc(groups, func(a, b string) int {
+		return cmp.Compare(grouped[b]["price"].(float64), grouped[a]["price"].(float64))
+	})
+
+	summary := make([]map[string]any, 0, len(groups)+1)
+	for _, value := range groups {
+		grouped[value]["price"] = roundPrice(grouped[value]["price"].(float64))
+		summary = append(summary, grouped[value])
+	}
+	summary = append(summary, map[string]any{groupBy: "total", "price": roundPrice(total)})
+
+	return summary, []string{groupBy, "lines", "price"}
+}
+
+// renderCSV returns the given lines in CSV format
+func renderCSV(lines []map[string]any, columns []string) (string, error) {
+	var out strings.Builder
+	writer := csv.NewWriter(&out)
+
+	if err := writer.Write(columns); err != nil {
+		return "", err
+	}
+	for _, line := range lines {
+		record := make([]string, 0, len(columns))
+		for _, column := range columns {
+			if value, ok := line[column]; ok {
+				record = append(record, fmt.Sprint(value))
+			} else {
+				record = append(record, "")
+			}
+		}
+		if err := writer.Write(record); err != nil {
+			return "", err
+		}
+	}
+	writer.Flush()
+
+	return out.String(), writer.Error()
+}
+
+// parseUsageTime parses a date given as a duration relative to now
+// (e.g. 30d), as a day (2025-01-31) or as a RFC3339 timestamp
+func parseUsageTime(value string) (time.Time, error) {
+	if duration, err := utils.ParseDuration(value); err == nil {
+		return time.Now().Add(-duration), nil
+	}
+	if date, err := time.Parse(time.DateOnly, value); err == nil {
+		return date, nil
+	}
+	if date, err := time.Parse(time.RFC3339, value); err == nil {
+		return date, nil
+	}
+
+	return time.Time{}, fmt.Errorf("invalid date %q, expected a duration (30d), a date (2025-01-31) or a RFC3339 timestamp", value)
+}
+
+// displayProjectUsage flattens, filters and displays the given usages
+func displayProjectUsage(usages []projectUsage) {
+	var lines []map[string]any
+	for _, usage := range usages {
+		usageLines, err := flattenProjectUsage(usage)
+		if err != nil {
+			display.OutputError(&flags.OutputFormatConfig, "%s", err)
+			return
+		}
+		lines = append(lines, usageLines...)
+	}
+
+	lines, err := filtersLib.FilterLines(lines, flags.GenericFilters)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to filter results: %s", err)
+		return
+	}
+
+	lines, columns := summarizeProjectUsage(lines, CloudProjectUsageParams.GroupBy)
+
+	// Keep decimals of prices and quantities when rendered in a table
+	for _, line := range lines {
+		for key, value := range line {
+			if number, ok := value.(float64); ok {
+				line[key] = json.Number(strconv.FormatFloat(number, 'f', -1, 64))
+			}
+		}
+	}
+
+	if CloudProjectUsageParams.CSV {
+		content, err := renderCSV(lines, columns)
+		if err != nil {
+			display.OutputError(&flags.OutputFormatConfig, "failed to render CSV: %s", err)
+			return
+		}
+		display.OutputInfo(&flags.OutputFormatConfig, nil, "%s", strings.TrimSuffix(content, "\n"))
+		return
+	}
+
+	display.RenderTable(lines, columns, &flags.OutputFormatConfig)
+}
+
+func checkProjectUsageGroupBy() error {
+	if CloudProjectUsageParams.GroupBy != "" && !slices.Contains(CloudProjectUsageGroupings, CloudProjectUsageParams.GroupBy) {
+		return fmt.Errorf("invalid value for --group-by, must be one of %s", strings.Join(CloudProjectUsageGroupings, ", "))
+	}
+	return nil
+}
+
+// fetchProjectUsage fetches the usage at the given endpoint of the project
+func fetchProjectUsage(projectID, endpoint string) (projectUsage, error) {
+	var usage projectUsage
+	if err := httpLib.Client.Get(fmt.Sprintf("/v1/cloud/project/%s/usage/%s", projectID, endpoint), &usage); err != nil {
+		return usage, err
+	}
+
+	return usage, nil
+}
+
+func GetCloudProjectCurrentUsage(_ *cobra.Command, _ []string) {
+	projectID, err := getConfiguredCloudProject()
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+	if err := checkProjectUsageGroupBy(); err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	usage, err := fetchProjectUsage(projectID, "current")
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to fetch current usage: %s", err)
+		return
+	}
+
+	displayProjectUsage([]projectUsage{usage})
+}
+
+func GetCloudProjectForecastUsage(_ *cobra.Command, _ []string) {
+	projectID, err := getConfiguredCloudProject()
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+	if err := checkProjectUsageGroupBy(); err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	usage, err := fetchProjectUsage(projectID, "forecast")
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to fetch usage forecast: %s", err)
+		return
+	}
+
+	displayProjectUsage([]projectUsage{usage})
+}
+
+func GetCloudProjectUsageHistory(_ *cobra.Command, _ []string) {
+	projectID, err := getConfiguredCloudProject()
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+	if err := checkProjectUsageGroupBy(); err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	query := url.Values{}
+	for param, value := range map[string]string{
+		"from": CloudProjectUsageParams.Since,
+		"to":   CloudProjectUsageParams.Until,
+	} {
+		if value == "" {
+			continue
+		}
+		date, err := parseUsageTime(value)
+		if err != nil {
+			display.OutputError(&flags.OutputFormatConfig, "%s", err)
+			return
+		}
+		query.Set(param, date.UTC().Format(time.RFC3339))
+	}
+
+	endpoint := fmt.Sprintf("/v1/cloud/project/%s/usage/history", projectID)
+	if len(query) > 0 {
+		endpoint += "?" + query.Encode()
+	}
+
+	var history []struct {
+		ID string `json:"id"`
+	}
+	if err := httpLib.Client.Get(endpoint, &history); err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to fetch usage history: %s", err)
+		return
+	}
+
+	usages := make([]projectUsage, 0, len(history))
+	for _, entry := range history {
+		usage, err := fetchProjectUsage(projectID, "history/"+url.PathEscape(entry.ID))
+		if err != nil {
+			display.OutputError(&flags.OutputFormatConfig, "failed to fetch usage %s: %s", entry.ID, err)
+			return
+		}
+		usages = append(usages, usage)
+	}
+
+	displayProjectUsage(usages)
+}
@@ -0,0 +1,616 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cloud
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+	"net/netip"
+	"net/url"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/ovh/ovhcloud-cli/internal/display"
+	filtersLib "github.com/ovh/ovhcloud-cli/internal/filters"
+	"github.com/ovh/ovhcloud-cli/internal/flags"
+	httpLib "github.com/ovh/ovhcloud-cli/internal/http"
+	"github.com/spf13/cobra"
+)
+
+var (
+	cloudprojectSecurityGroupColumnsToDisplay = []string{"id", "name", "region", "description"}
+
+	//go:embed templates/cloud_security_group.tmpl
+	cloudSecurityGroupTemplate string
+
+	// SecurityGroupDescription is the description of the security group to create.
+	SecurityGroupDescription string
+
+	// SecurityGroupRuleSpec holds the parameters of the security group rule to add.
+	SecurityGroupRuleSpec SecurityGroupRuleDefinition
+
+	// SecurityGroupImportParams holds the parameters of a security group import.
+	SecurityGroupImportParams struct {
+		DryRun bool
+	}
+
+	// SecurityGroupExportFile is the file to write the exported security group to.
+	SecurityGroupExportFile string
+
+	// Protocols whose rules can be restricted to a port range
+	securityGroupPortProtocols = []string{"tcp", "udp", "udplite", "sctp", "dccp"}
+
+	// Protocols whose rules can be restricted to an ICMP type and code
+	securityGroupICMPProtocols = []string{"icmp", "icmpv6", "ipv6-icmp"}
+)
+
+// SecurityGroupDefinition is the description of a security group, as exported and imported in YAML
+type SecurityGroupDefinition struct {
+	Name        string                        `json:"name"`
+	Description string                        `json:"description,omitempty"`
+	Rules       []SecurityGroupRuleDefinition `json:"rules"`
+}
+
+// SecurityGroupRuleDefinition is the description of a security group rule, as exported and imported in YAML
+type SecurityGroupRuleDefinition struct {
+	Direction   string `json:"direction"`
+	Protocol    string `json:"protocol,omitempty"`
+	Port        string `json:"port,omitempty"`
+	ICMPType    *int   `json:"icmpType,omitempty"`
+	ICMPCode    *int   `json:"icmpCode,omitempty"`
+	CIDR        string `json:"cidr,omitempty"`
+	RemoteGroup string `json:"remoteGroup,omitempty"`
+	Ethertype   string `json:"ethertype,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// securityGroupRule is a security group rule as returned by the API
+type securityGroupRule struct {
+	ID             string `json:"id,omitempty"`
+	Direction      string `json:"direction"`
+	Ethertype      string `json:"ethertype"`
+	Protocol       string `json:"protocol,omitempty"`
+	PortRangeMin   *int   `json:"portRangeMin,omitempty"`
+	PortRangeMax   *int   `json:"portRangeMax,omitempty"`
+	RemoteIPPrefix string `json:"remoteIpPrefix,omitempty"`
+	RemoteGroupID  string `json:"remoteGroupId,omitempty"`
+	Description    string `json:"description,omitempty"`
+}
+
+type securityGroup struct {
+	ID          string              `json:"id"`
+	Name        string              `json:"name"`
+	Description string              `json:"description"`
+	Rules       []securityGroupRule `json:"rules"`
+}
+
+// toAPIRule converts the rule definition to the API format
+func (r SecurityGroupRuleDefinition) toAPIRule() (securityGroupRule, error) {
+	rule := securityGroupRule{
+		Direction:     r.Direction,
+		Ethertype:     r.Ethertype,
+		RemoteGroupID: r.RemoteGroup,
+		Description:   r.Description,
+	}
+
+	if rule.Direction == "" {
+		rule.Direction = "ingress"
+	}
+	if rule.Direction != "ingress" && rule.Direction != "egress" {
+		return rule, fmt.Errorf("invalid direction %q, must be ingress or egress", r.Direction)
+	}
+
+	// Other protocols are passed as is to the API, so that any exported rule can be imported back
+	switch r.Protocol {
+	case "", "any":
+	default:
+		rule.Protocol = strings.ToLower(r.Protocol)
+	}
+
+	if r.Port != "" {
+		if !slices.Contains(securityGroupPortProtocols, rule.Protocol) {
+			return rule, fmt.Errorf("a port can only be given for %s rules", strings.Join(securityGroupPortProtocols, ", "))
+		}
+
+		minPort, maxPort, isRange := strings.Cut(r.Port, "-")
+		if !isRange {
+			maxPort = minPort
+		}
+
+		portMin, err := strconv.Atoi(minPort)
+		if err != nil || portMin < 1 || portMin > 65535 {
+			return rule, fmt.Errorf("invalid port %q", r.Port)
+		}
+		portMax, err := strconv.Atoi(maxPort)
+		if err != nil || portMax < portMin || portMax > 65535 {
+			return rule, fmt.Errorf("invalid port %q", r.Port)
+		}
+		rule.PortRangeMin, rule.PortRangeMax = &portMin, &portMax
+	}
+
+	// ICMP type and code are stored in the port range of the rule
+	if r.ICMPType != nil || r.ICMPCode != nil {
+		switch {
+		case !slices.Contains(securityGroupICMPProtocols, rule.Protocol):
+			return rule, fmt.Errorf("an ICMP type and code can only be given for %s rules", strings.Join(securityGroupICMPProtocols, ", "))
+		case r.ICMPType == nil:
+			return rule, errors.New("an ICMP code can only be given with an ICMP type")
+		case *r.ICMPType < 0 || *r.ICMPType > 255:
+			return rule, fmt.Errorf("invalid ICMP type %d", *r.ICMPType)
+		case r.ICMPCode != nil && (*r.ICMPCode < 0 || *r.ICMPCode > 255):
+			return rule, fmt.Errorf("invalid ICMP code %d", *r.ICMPCode)
+		}
+		rule.PortRangeMin, rule.PortRangeMax = r.ICMPType, r.ICMPCode
+	}
+
+	if r.CIDR != "" && r.RemoteGroup != "" {
+		return rule, errors.New("cidr and remote group are mutually exclusive")
+	}
+	if r.CIDR != "" {
+		prefix, err := netip.ParsePrefix(r.CIDR)
+		if err != nil {
+			return rule, fmt.Errorf("invalid CIDR %q", r.CIDR)
+		}
+		rule.RemoteIPPrefix = prefix.Masked().String()
+
+		if rule.Ethertype == "" && prefix.Addr().Is6() {
+			rule.Ethertype = "IPv6"
+		}
+	}
+	if rule.Ethertype == "" {
+		rule.Ethertype = "IPv4"
+	}
+
+	return rule, nil
+}
+
+// key returns a string identifying the behaviour of the rule, used to compare rules
+func (r securityGroupRule) key() string {
+	optional := func(value *int) string {
+		if value == nil {
+			return ""
+		}
+		return strconv.Itoa(*value)
+	}
+
+	return fmt.Sprintf("%s/%s/%s/%s-%s/%s/%s", r.Direction, r.Ethertype, r.Protocol, optional(r.PortRangeMin), optional(r.PortRangeMax), r.RemoteIPPrefix, r.RemoteGroupID)
+}
+
+// toDefinition converts the API rule to the format used in exports
+func (r securityGroupRule) toDefinition() SecurityGroupRuleDefinition {
+	definition := SecurityGroupRuleDefinition{
+		Direction:   r.Direction,
+		Protocol:    r.Protocol,
+		CIDR:        r.RemoteIPPrefix,
+		RemoteGroup: r.RemoteGroupID,
+		Ethertype:   r.Ethertype,
+		Description: r.Description,
+	}
+
+	switch {
+	case slices.Contains(securityGroupICMPProtocols, r.Protocol):
+		definition.ICMPType, definition.ICMPCode = r.PortRangeMin, r.PortRangeMax
+	case r.PortRangeMin == nil:
+	case r.PortRangeMax == nil || *r.PortRangeMin == *r.PortRangeMax:
+		definition.Port = strconv.Itoa(*r.PortRangeMin)
+	default:
+		definition.Port = fmt.Sprintf("%d-%d", *r.PortRangeMin, *r.PortRangeMax)
+	}
+
+	return definition
+}
+
+// fetchSecurityGroups returns the security groups of all the regions of the project
+func fetchSecurityGroups(projectID string) ([]map[string]any, error) {
+	regions, err := getCloudRegionsWithFeatureAvailable(projectID, "network")
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch regions with network feature available: %w", err)
+	}
+
+	url := fmt.Sprintf("/v1/cloud/project/%s/region", projectID)
+	groups, err := httpLib.FetchObjectsParallel[[]map[string]any](url+"/%s/securityGroup", regions, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch security groups: %w", err)
+	}
+
+	// Flatten security groups in a single array, keeping track of their region
+	var allGroups []map[string]any
+	for i, regionGroups := range groups {
+		for _, group := range regionGroups {
+			group["region"] = regions[i]
+			allGroups = append(allGroups, group)
+		}
+	}
+
+	return allGroups, nil
+}
+
+// findSecurityGroup returns the endpoint and the region of the security group with the given ID
+func findSecurityGroup(projectID, groupID string) (string, string, error) {
+	groups, err := fetchSecurityGroups(projectID)
+	if err != nil {
+		return "", "", err
+	}
+
+	for _, group := range groups {
+		if group["id"] != groupID {
+			continue
+		}
+
+		region := fmt.Sprint(group["region"])
+		endpoint := fmt.Sprintf("/v1/cloud/project/%s/region/%s/securityGroup/%s",
+			projectID, url.PathEscape(region), url.PathEscape(groupID))
+
+		return endpoint, region, nil
+	}
+
+	return "", "", fmt.Errorf("no security group found with ID %q", groupID)
+}
+
+func ListSecurityGroups(_ *cobra.Command, _ []string) {
+	projectID, err := getConfiguredCloudProject()
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	groups, err := fetchSecurityGroups(projectID)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	groups, err = filtersLib.FilterLines(groups, flags.GenericFilters)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to filter results: %s", err)
+		return
+	}
+
+	display.RenderTable(groups, cloudprojectSecurityGroupColumnsToDisplay, &flags.OutputFormatConfig)
+}
+
+func GetSecurityGroup(_ *cobra.Command, args []string) {
+	projectID, err := getConfiguredCloudProject()
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	foundURL, region, err := findSecurityGroup(projectID, args[0])
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	var group map[string]any
+	if err := httpLib.Client.Get(foundURL, &group); err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to fetch security group: %s", err)
+		return
+	}
+	group["region"] = region
+
+	display.OutputObject(group, args[0], cloudSecurityGroupTemplate, &flags.OutputFormatConfig)
+}
+
+func createSecurityGroup(projectID, region, name, description string) (*securityGroup, error) {
+	var group securityGroup
+	if err := httpLib.Client.Post(
+		fmt.Sprintf("/v1/cloud/project/%s/region/%s/securityGroup", projectID, url.PathEscape(region)),
+		map[string]any{"name": name, "description": description},
+		&group,
+	); err != nil {
+		return nil, err
+	}
+
+	return &group, nil
+}
+
+func CreateSecurityGroup(_ *cobra.Command, args []string) {
+	projectID, err := getConfiguredCloudProject()
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	group, err := createSecurityGroup(projectID, args[0], args[1], SecurityGroupDescription)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to create security group: %s", err)
+		return
+	}
+
+	display.OutputInfo(&flags.OutputFormatConfig, map[string]any{"id": group.ID}, "✅ Security group %s created successfully (ID: %s)", args[1], group.ID)
+}
+
+func DeleteSecurityGroup(_ *cobra.Command, args []string) {
+	projectID, err := getConfiguredCloudProject()
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	foundURL, _, err := findSecurityGroup(projectID, args[0])
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	if err := httpLib.Client.Delete(foundURL, nil); err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to delete security group: %s", err)
+		return
+	}
+
+	display.OutputInfo(&flags.OutputFormatConfig, nil, "✅ Security group %s deleted successfully", args[0])
+}
+
+func AddSecurityGroupRule(_ *cobra.Command, args []string) {
+	projectID, err := getConfiguredCloudProject()
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	rule, err := SecurityGroupRuleSpec.toAPIRule()
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	foundURL, _, err := findSecurityGroup(projectID, args[0])
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	var created map[string]any
+	if err := httpLib.Client.Post(foundURL+"/rule", rule, &created); err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to add rule: %s", err)
+		return
+	}
+
+	display.OutputInfo(&flags.OutputFormatConfig, created, "✅ Rule %s added to security group %s", created["id"], args[0])
+}
+
+func RemoveSecurityGroupRule(_ *cobra.Command, args []string) {
+	projectID, err := getConfiguredCloudProject()
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	foundURL, _, err := findSecurityGroup(projectID, args[0])
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	if err := httpLib.Client.Delete(foundURL+"/rule/"+url.PathEscape(args[1]), nil); err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to remove rule: %s", err)
+		return
+	}
+
+	display.OutputInfo(&flags.OutputFormatConfig, nil, "✅ Rule %s removed from security group %s", args[1], args[0])
+}
+
+func ExportSecurityGroup(_ *cobra.Command, args []string) {
+	projectID, err := getConfiguredCloudProject()
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	foundURL, _, err := findSecurityGroup(projectID, args[0])
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	var group securityGroup
+	if err := httpLib.Client.Get(foundURL, &group); err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to fetch security group: %s", err)
+		return
+	}
+
+	definition := SecurityGroupDefinition{
+		Name:        group.Name,
+		Description: group.Description,
+		Rules:       make([]SecurityGroupRuleDefinition, 0, len(group.Rules)),
+	}
+	for _, rule := range group.Rules {
+		definition.Rules = append(definition.Rules, rule.toDefinition())
+	}
+
+	content, err := yaml.Marshal(definition)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to marshal security group: %s", err)
+		return
+	}
+
+	if SecurityGroupExportFile == "" {
+		display.OutputInfo(&flags.OutputFormatConfig, definition, "%s", strings.TrimSuffix(string(content), "\n"))
+		return
+	}
+
+	if err := os.WriteFile(SecurityGroupExportFile, content, 0o644); err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to write file: %s", err)
+		return
+	}
+
+	display.OutputInfo(&flags.OutputFormatConfig, nil, "✅ Security group %s exported to %s", args[0], SecurityGroupExportFile)
+}
+
+// fetchSecurityGroupRules returns the rules of the security group at the given URL
+func fetchSecurityGroupRules(groupURL string) ([]securityGroupRule, error) {
+	var details securityGroup
+	if err := httpLib.Client.Get(groupURL, &details); err != nil {
+		return nil, fmt.Errorf("failed to fetch security group: %w", err)
+	}
+
+	return details.Rules, nil
+}
+
+// diffSecurityGroupRules returns the rules to add and to remove to go from the
+// current rules to the wanted ones
+func diffSecurityGroupRules(current, wanted []securityGroupRule) (toAdd, toRemove []securityGroupRule) {
+	existingKeys := []string{}
+	for _, rule := range current {
+		existingKeys = append(existingKeys, rule.key())
+		if !slices.ContainsFunc(wanted, func(wanted securityGroupRule) bool { return wanted.key() == rule.key() }) {
+			toRemove = append(toRemove, rule)
+		}
+	}
+	for _, rule := range wanted {
+		if !slices.Contains(existingKeys, rule.key()) {
+			toAdd = append(toAdd, rule)
+			existingKeys = append(existingKeys, rule.key())
+		}
+	}
+
+	return toAdd, toRemove
+}
+
+func ImportSecurityGroup(_ *cobra.Command, args []string) {
+	projectID, err := getConfiguredCloudProject()
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+	region := args[0]
+
+	content, err := os.ReadFile(args[1])
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to read file: %s", err)
+		return
+	}
+
+	var definition SecurityGroupDefinition
+	if err := yaml.Unmarshal(content, &definition); err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to parse file: %s", err)
+		return
+	}
+	if definition.Name == "" {
+		display.OutputError(&flags.OutputFormatConfig, "security group name is missing in file")
+		return
+	}
+
+	wantedRules := make([]securityGroupRule, 0, len(definition.Rules))
+	for i, ruleDefinition := range definition.Rules {
+		rule, err := ruleDefinition.toAPIRule()
+		if err != nil {
+			display.OutputError(&flags.OutputFormatConfig, "invalid rule #%d: %s", i+1, err)
+			return
+		}
+		wantedRules = append(wantedRules, rule)
+	}
+
+	// Look for an existing group with the same name in the region
+	var groups []securityGroup
+	endpoint := fmt.Sprintf("/v1/cloud/project/%s/region/%s/securityGroup", projectID, url.PathEscape(region))
+	if err := httpLib.Client.Get(endpoint, &groups); err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to fetch security groups: %s", err)
+		return
+	}
+
+	var group *securityGroup
+	for _, existing := range groups {
+		if existing.Name == definition.Name {
+			if group != nil {
+				display.OutputError(&flags.OutputFormatConfig, "several security groups are named %q in region %s", definition.Name, region)
+				return
+			}
+			group = &existing
+		}
+	}
+
+	// Compute rules to add and to remove
+	var current []securityGroupRule
+	if group != nil {
+		if current, err = fetchSecurityGroupRules(endpoint + "/" + url.PathEscape(group.ID)); err != nil {
+			display.OutputError(&flags.OutputFormatConfig, "%s", err)
+			return
+		}
+	}
+	toAdd, toRemove := diffSecurityGroupRules(current, wantedRules)
+
+	details := map[string]any{
+		"name":         definition.Name,
+		"rulesAdded":   len(toAdd),
+		"rulesRemoved": len(toRemove),
+	}
+
+	if SecurityGroupImportParams.DryRun {
+		action := "updated"
+		if group == nil {
+			action = "created"
+		}
+		details["created"] = group == nil
+		display.OutputInfo(&flags.OutputFormatConfig, details, "Dry run: security group %s would be %s with %d rule(s) added and %d rule(s) removed",
+			definition.Name, action, len(toAdd), len(toRemove))
+		return
+	}
+
+	if group == nil {
+		group, err = createSecurityGroup(projectID, region, definition.Name, definition.Description)
+		if err != nil {
+			display.OutputError(&flags.OutputFormatConfig, "failed to create security group: %s", err)
+			return
+		}
+		details["created"] = true
+
+		// New groups come with default rules (e.g. egress), so the changes are
+		// computed again from the actual rules of the group
+		if current, err = fetchSecurityGroupRules(endpoint + "/" + url.PathEscape(group.ID)); err != nil {
+			display.OutputError(&flags.OutputFormatConfig, "security group %s created, but %s", group.ID, err)
+			return
+		}
+		toAdd, toRemove = diffSecurityGroupRules(current, wantedRules)
+		details["rulesAdded"] = len(toAdd)
+		details["rulesRemoved"] = len(toRemove)
+	}
+	groupURL := endpoint + "/" + url.PathEscape(group.ID)
+
+	for _, rule := range toRemove {
+		if err := httpLib.Client.Delete(groupURL+"/rule/"+url.PathEscape(rule.ID), nil); err != nil {
+			display.OutputError(&flags.OutputFormatConfig, "failed to remove rule %s: %s", rule.ID, err)
+			return
+		}
+	}
+	for _, rule := range toAdd {
+		if err := httpLib.Client.Post(groupURL+"/rule", rule, nil); err != nil {
+			display.OutputError(&flags.OutputFormatConfig, "failed to add rule: %s", err)
+			return
+		}
+	}
+
+	details["id"] = group.ID
+	display.OutputInfo(&flags.OutputFormatConfig, details, "✅ Security group %s (ID: %s) imported: %d rule(s) added, %d rule(s) removed",
+		definition.Name, group.ID, len(toAdd), len(toRemove))
+}
+
+func SetInstanceInterfaceSecurityGroups(_ *cobra.Command, args []string) {
+	projectID, err := getConfiguredCloudProject()
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	_, region, err := getInstanceNameAndRegion(projectID, args[0])
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	endpoint := fmt.Sprintf("/v1/cloud/project/%s/region/%s/instance/%s/interface/%s",
+		projectID, url.PathEscape(region), url.PathEscape(args[0]), url.PathEscape(args[1]))
+	if err := httpLib.Client.Put(endpoint, map[string]any{"securityGroups": args[2:]}, nil); err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to set security groups of interface %s: %s", args[1], err)
+		return
+	}
+
+	display.OutputInfo(&flags.OutputFormatConfig, nil, "✅ Security groups of interface %s updated successfully", args[1])
+}
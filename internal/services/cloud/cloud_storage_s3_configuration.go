@@ -0,0 +1,250 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/ghodss/yaml"
+	"github.com/ovh/ovhcloud-cli/internal/assets"
+	"github.com/ovh/ovhcloud-cli/internal/display"
+	"github.com/ovh/ovhcloud-cli/internal/flags"
+	httpLib "github.com/ovh/ovhcloud-cli/internal/http"
+	"github.com/ovh/ovhcloud-cli/internal/openapi"
+	"github.com/spf13/cobra"
+)
+
+const (
+	storageS3ContainerSchemaPath = "/cloud/project/{serviceName}/region/{regionName}/storage/{name}"
+	storageS3LifecycleSchemaPath = "/cloud/project/{serviceName}/region/{regionName}/storage/{name}/lifecycle"
+	storageS3CORSSchemaPath      = "/cloud/project/{serviceName}/region/{regionName}/storage/{name}/cors"
+)
+
+// readStorageS3Document reads a JSON or YAML document from the given file,
+// or from the standard input if the file is "-"
+func readStorageS3Document(path string) (map[string]any, error) {
+	var (
+		content []byte
+		err     error
+	)
+	if path == "-" {
+		content, err = io.ReadAll(os.Stdin)
+	} else {
+		content, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read document: %w", err)
+	}
+
+	// YAML being a superset of JSON, both formats are handled here
+	jsonContent, err := yaml.YAMLToJSON(content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse document: %w", err)
+	}
+
+	var document map[string]any
+	if err := json.Unmarshal(jsonContent, &document); err != nil {
+		return nil, fmt.Errorf("document must be an object: %w", err)
+	}
+
+	return document, nil
+}
+
+// getStorageS3ContainerURL returns the API URL of the given container
+func getStorageS3ContainerURL(containerName string) (string, map[string]any, error) {
+	projectID, err := getConfiguredCloudProject()
+	if err != nil {
+		return "", nil, err
+	}
+
+	return locateStorageS3Container(projectID, containerName)
+}
+
+// updateStorageS3Container updates a single property of the given container. The full
+// editable body is sent after being validated against the API schema.
+func updateStorageS3Container(foundURL string, container map[string]any, property string, value any) error {
+	container[property] = value
+
+	body, err := openapi.FilterEditableFields(assets.CloudOpenapiSchema, storageS3ContainerSchemaPath, "put", container)
+	if err != nil {
+		return fmt.Errorf("failed to extract writable properties: %w", err)
+	}
+
+	if err := openapi.ValidateRequestBody(assets.CloudOpenapiSchema, storageS3ContainerSchemaPath, "put", body); err != nil {
+		return fmt.Errorf("invalid %s configuration: %w", property, err)
+	}
+
+	if err := httpLib.Client.Put(foundURL, body, nil); err != nil {
+		return fmt.Errorf("failed to update storage container: %w", err)
+	}
+
+	return nil
+}
+
+// getStorageS3ContainerProperty displays a single property of the given container
+func getStorageS3ContainerProperty(containerName, property string) {
+	_, container, err := getStorageS3ContainerURL(containerName)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	value, ok := container[property].(map[string]any)
+	if !ok {
+		value = map[string]any{}
+	}
+
+	display.OutputObject(value, containerName, "", &flags.OutputFormatConfig)
+}
+
+// setStorageS3ContainerProperty updates a single property of the given container
+// using the document read from the given file
+func setStorageS3ContainerProperty(containerName, property, documentPath string) error {
+	document, err := readStorageS3Document(documentPath)
+	if err != nil {
+		return err
+	}
+
+	foundURL, container, err := getStorageS3ContainerURL(containerName)
+	if err != nil {
+		return err
+	}
+
+	return updateStorageS3Container(foundURL, container, property, document)
+}
+
+// setStorageS3SubResource validates the document read from the given file and
+// sends it to the given sub-resource of the container
+func setStorageS3SubResource(containerName, subResource, schemaPath, documentPath string) error {
+	document, err := readStorageS3Document(documentPath)
+	if err != nil {
+		return err
+	}
+
+	if err := openapi.ValidateRequestBody(assets.CloudOpenapiSchema, schemaPath, "put", document); err != nil {
+		return fmt.Errorf("invalid %s configuration: %w", subResource, err)
+	}
+
+	foundURL, _, err := getStorageS3ContainerURL(containerName)
+	if err != nil {
+		return err
+	}
+
+	if err := httpLib.Client.Put(foundURL+"/"+subResource, document, nil); err != nil {
+		return fmt.Errorf("failed to update %s configuration: %w", subResource, err)
+	}
+
+	return nil
+}
+
+// getStorageS3SubResource displays the given sub-resource of the container
+func getStorageS3SubResource(containerName, subResource string) {
+	foundURL, _, err := getStorageS3ContainerURL(containerName)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	var object map[string]any
+	if err := httpLib.Client.Get(foundURL+"/"+subResource, &object); err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to fetch %s configuration: %s", subResource, err)
+		return
+	}
+
+	display.OutputObject(object, containerName, "", &flags.OutputFormatConfig)
+}
+
+func GetStorageS3Lifecycle(_ *cobra.Command, args []string) {
+	getStorageS3SubResource(args[0], "lifecycle")
+}
+
+func SetStorageS3Lifecycle(_ *cobra.Command, args []string) {
+	if err := setStorageS3SubResource(args[0], "lifecycle", storageS3LifecycleSchemaPath, args[1]); err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	display.OutputInfo(&flags.OutputFormatConfig, nil, "✅ Lifecycle configuration of container %s updated successfully", args[0])
+}
+
+func DeleteStorageS3Lifecycle(_ *cobra.Command, args []string) {
+	foundURL, _, err := getStorageS3ContainerURL(args[0])
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	if err := httpLib.Client.Delete(foundURL+"/lifecycle", nil); err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to delete lifecycle configuration: %s", err)
+		return
+	}
+
+	display.OutputInfo(&flags.OutputFormatConfig, nil, "✅ Lifecycle configuration of container %s deleted successfully", args[0])
+}
+
+func GetStorageS3CORS(_ *cobra.Command, args []string) {
+	getStorageS3SubResource(args[0], "cors")
+}
+
+func SetStorageS3CORS(_ *cobra.Command, args []string) {
+	if err := setStorageS3SubResource(args[0], "cors", storageS3CORSSchemaPath, args[1]); err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	display.OutputInfo(&flags.OutputFormatConfig, nil, "✅ CORS configuration of container %s updated successfully", args[0])
+}
+
+func setStorageS3Versioning(containerName, status string) {
+	foundURL, container, err := getStorageS3ContainerURL(containerName)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	if err := updateStorageS3Container(foundURL, container, "versioning", map[string]any{"status": status}); err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	display.OutputInfo(&flags.OutputFormatConfig, nil, "✅ Versioning of container %s is now %s", containerName, status)
+}
+
+func EnableStorageS3Versioning(_ *cobra.Command, args []string) {
+	setStorageS3Versioning(args[0], "enabled")
+}
+
+func SuspendStorageS3Versioning(_ *cobra.Command, args []string) {
+	setStorageS3Versioning(args[0], "suspended")
+}
+
+func GetStorageS3Replication(_ *cobra.Command, args []string) {
+	getStorageS3ContainerProperty(args[0], "replication")
+}
+
+func SetStorageS3Replication(_ *cobra.Command, args []string) {
+	if err := setStorageS3ContainerProperty(args[0], "replication", args[1]); err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	display.OutputInfo(&flags.OutputFormatConfig, nil, "✅ Replication configuration of container %s updated successfully", args[0])
+}
+
+func GetStorageS3ObjectLock(_ *cobra.Command, args []string) {
+	getStorageS3ContainerProperty(args[0], "objectLock")
+}
+
+func SetStorageS3ObjectLock(_ *cobra.Command, args []string) {
+	if err := setStorageS3ContainerProperty(args[0], "objectLock", args[1]); err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	display.OutputInfo(&flags.OutputFormatConfig, nil, "✅ Object lock configuration of container %s updated successfully", args[0])
+}
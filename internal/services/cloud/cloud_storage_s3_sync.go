@@ -0,0 +1,463 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cloud
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ovh/ovhcloud-cli/internal/display"
+	"github.com/ovh/ovhcloud-cli/internal/flags"
+	httpLib "github.com/ovh/ovhcloud-cli/internal/http"
+	"github.com/spf13/cobra"
+)
+
+const storageS3SyncScheme = "s3://"
+
+var (
+	// StorageS3SyncParams holds the options of the sync command.
+	StorageS3SyncParams struct {
+		Delete      bool
+		DryRun      bool
+		Include     []string
+		Exclude     []string
+		Concurrency int
+	}
+)
+
+// storageS3SyncEntry describes a file or an object compared during a sync
+type storageS3SyncEntry struct {
+	size    int64
+	etag    string
+	modTime time.Time
+}
+
+// parseStorageS3SyncURL parses a location formatted as s3://<container>/<prefix>
+func parseStorageS3SyncURL(location string) (string, string, error) {
+	trimmed := strings.TrimPrefix(location, storageS3SyncScheme)
+	container, prefix, _ := strings.Cut(trimmed, "/")
+	if container == "" {
+		return "", "", fmt.Errorf("invalid location %q, expected s3://<container_name>/<prefix>", location)
+	}
+
+	if prefix != "" && !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+
+	return container, prefix, nil
+}
+
+// storageS3SyncMatches checks the given relative path against the include and exclude
+// patterns. Patterns are matched against the whole relative path and against its base name.
+func storageS3SyncMatches(relPath string) bool {
+	match := func(patterns []string) bool {
+		for _, pattern := range patterns {
+			if ok, _ := path.Match(pattern, relPath); ok {
+				return true
+			}
+			if ok, _ := path.Match(pattern, path.Base(relPath)); ok {
+				return true
+			}
+		}
+		return false
+	}
+
+	if len(StorageS3SyncParams.Include) > 0 && !match(StorageS3SyncParams.Include) {
+		return false
+	}
+
+	return !match(StorageS3SyncParams.Exclude)
+}
+
+// listStorageS3SyncObjects lists all objects under the given prefix, indexed by
+// their path relative to the prefix
+func listStorageS3SyncObjects(apiURL, prefix string) (map[string]storageS3SyncEntry, error) {
+	const pageSize = 1000
+
+	objects := make(map[string]storageS3SyncEntry)
+	params := url.Values{
+		"limit": {strconv.Itoa(pageSize)},
+	}
+	if prefix != "" {
+		params.Set("prefix", prefix)
+	}
+
+	for {
+		var page []struct {
+			Key          string    `json:"key"`
+			Size         int64     `json:"size"`
+			ETag         string    `json:"etag"`
+			LastModified time.Time `json:"lastModified"`
+		}
+		if err := httpLib.Client.Get(apiURL+"/object?"+params.Encode(), &page); err != nil {
+			return nil, fmt.Errorf("failed to list objects: %w", err)
+		}
+
+		// Pages may be shorter than the limit, only an empty page ends the listing
+		if len(page) == 0 {
+			return objects, nil
+		}
+
+		for _, object := range page {
+			relPath := strings.TrimPrefix(object.Key, prefix)
+
+			// Skip folder markers
+			if relPath == "" || strings.HasSuffix(relPath, "/") {
+				continue
+			}
+
+			objects[relPath] = storageS3SyncEntry{
+				size:    object.Size,
+				etag:    strings.Trim(object.ETag, `"`),
+				modTime: object.LastModified,
+			}
+		}
+
+		params.Set("keyMarker", page[len(page)-1].Key)
+	}
+}
+
+// listStorageS3SyncFiles lists all regular files in the given directory, indexed
+// by their slash-separated path relative to the directory
+func listStorageS3SyncFiles(dir string) (map[string]storageS3SyncEntry, error) {
+	files := make(map[string]storageS3SyncEntry)
+
+	err := filepath.WalkDir(dir, func(filePath string, d fs.DirEntry, err error) error {
+		if err != nil {
+			// Destination directory of a download may not exist yet
+			if filePath == dir && errors.Is(err, fs.ErrNotExist) {
+				return filepath.SkipDir
+			}
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		relPath, err := filepath.Rel(dir, filePath)
+		if err != nil {
+			return err
+		}
+
+		files[filepath.ToSlash(relPath)] = storageS3SyncEntry{
+			size:    info.Size(),
+			modTime: info.ModTime(),
+		}
+		return nil
+	})
+
+	return files, err
+}
+
+// storageS3SyncNeedsTransfer tells if the source entry must be transferred to the destination.
+// Sizes are compared first, then the MD5 checksum when the ETag of the object is one, and
+// finally modification times.
+func storageS3SyncNeedsTransfer(localPath string, local, remote storageS3SyncEntry, upload bool) (bool, error) {
+	if local.size != remote.size {
+		return true, nil
+	}
+
+	if len(remote.etag) == 32 && !strings.Contains(remote.etag, "-") {
+		file, err := os.Open(localPath)
+		if err != nil {
+			return false, err
+		}
+		defer file.Close()
+
+		checksum, err := fileMD5(file, 0, local.size)
+		if err != nil {
+			return false, fmt.Errorf("failed to compute checksum of %s: %w", localPath, err)
+		}
+
+		return fmt.Sprintf("%x", checksum) != remote.etag, nil
+	}
+
+	if upload {
+		return local.modTime.After(remote.modTime), nil
+	}
+	return remote.modTime.After(local.modTime), nil
+}
+
+// storageS3SyncLocalPath returns the path of the given object in the local directory,
+// or an error if it would be written outside of it
+func storageS3SyncLocalPath(localDir, relPath string) (string, error) {
+	if !filepath.IsLocal(filepath.FromSlash(relPath)) {
+		return "", fmt.Errorf("object path %q leads outside of the local directory", relPath)
+	}
+
+	filePath := filepath.Join(localDir, filepath.FromSlash(relPath))
+	if rel, err := filepath.Rel(localDir, filePath); err != nil || !filepath.IsLocal(rel) {
+		return "", fmt.Errorf("object path %q leads outside of the local directory", relPath)
+	}
+
+	return filePath, nil
+}
+
+// runStorageS3SyncActions runs the given function on each path with bounded concurrency,
+// and returns the paths successfully processed
+func runStorageS3SyncActions(paths []string, fn func(relPath string) error) ([]string, error) {
+	var (
+		wg    sync.WaitGroup
+		mu    sync.Mutex
+		done  []string
+		errs  []error
+		queue = make(chan string)
+	)
+
+	for range max(StorageS3SyncParams.Concurrency, 1) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for relPath := range queue {
+				err := fn(relPath)
+
+				mu.Lock()
+				if err != nil {
+					errs = append(errs, fmt.Errorf("%s: %w", relPath, err))
+				} else {
+					done = append(done, relPath)
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	for _, relPath := range paths {
+		queue <- relPath
+	}
+	close(queue)
+	wg.Wait()
+
+	sort.Strings(done)
+	return done, errors.Join(errs...)
+}
+
+func StorageS3Sync(_ *cobra.Command, args []string) {
+	projectID, err := getConfiguredCloudProject()
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	source, destination := args[0], args[1]
+	upload := strings.HasPrefix(destination, storageS3SyncScheme)
+	if upload == strings.HasPrefix(source, storageS3SyncScheme) {
+		display.OutputError(&flags.OutputFormatConfig, "exactly one of source and destination must be a s3://<container_name>/<prefix> location")
+		return
+	}
+
+	localDir, remoteLocation := source, destination
+	if !upload {
+		localDir, remoteLocation = destination, source
+	}
+
+	containerName, prefix, err := parseStorageS3SyncURL(remoteLocation)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	partSize := int64(StorageS3TransferParams.PartSize) * 1024 * 1024
+	if upload && partSize < storageS3MinPartSize {
+		display.OutputError(&flags.OutputFormatConfig, "part size must be at least 5 MiB")
+		return
+	}
+
+	target, err := locateStorageS3Target(projectID, containerName)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	remoteEntries, err := listStorageS3SyncObjects(target.apiURL, prefix)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	localEntries, err := listStorageS3SyncFiles(localDir)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to list local files: %s", err)
+		return
+	}
+
+	sourceEntries, destinationEntries := localEntries, remoteEntries
+	if !upload {
+		sourceEntries, destinationEntries = remoteEntries, localEntries
+	}
+
+	// Compute the list of paths to transfer and to delete
+	var toTransfer, toDelete []string
+	skipped := 0
+	for relPath, sourceEntry := range sourceEntries {
+		if !storageS3SyncMatches(relPath) {
+			continue
+		}
+
+		// Object keys must not lead outside of the local directory
+		if !upload && !filepath.IsLocal(filepath.FromSlash(relPath)) {
+			log.Printf("Skipping object %s%s, its key leads outside of the local directory", prefix, relPath)
+			continue
+		}
+
+		destinationEntry, ok := destinationEntries[relPath]
+		if ok {
+			local, remote := sourceEntry, destinationEntry
+			if !upload {
+				local, remote = destinationEntry, sourceEntry
+			}
+
+			needed, err := storageS3SyncNeedsTransfer(filepath.Join(localDir, filepath.FromSlash(relPath)), local, remote, upload)
+			if err != nil {
+				display.OutputError(&flags.OutputFormatConfig, "%s", err)
+				return
+			}
+			if !needed {
+				skipped++
+				continue
+			}
+		}
+
+		toTransfer = append(toTransfer, relPath)
+	}
+
+	if StorageS3SyncParams.Delete {
+		for relPath := range destinationEntries {
+			if _, ok := sourceEntries[relPath]; !ok && storageS3SyncMatches(relPath) {
+				toDelete = append(toDelete, relPath)
+			}
+		}
+	}
+
+	sort.Strings(toTransfer)
+	sort.Strings(toDelete)
+
+	if StorageS3SyncParams.DryRun {
+		var sb strings.Builder
+		sb.WriteString("Dry run, the following changes would be applied:\n")
+		for _, relPath := range toTransfer {
+			if upload {
+				fmt.Fprintf(&sb, "-> upload: %s\n", relPath)
+			} else {
+				fmt.Fprintf(&sb, "-> download: %s\n", relPath)
+			}
+		}
+		for _, relPath := range toDelete {
+			fmt.Fprintf(&sb, "-> delete: %s\n", relPath)
+		}
+		fmt.Fprintf(&sb, "%d file(s) already up to date", skipped)
+
+		display.OutputInfo(&flags.OutputFormatConfig, map[string]any{
+			"transfer": toTransfer,
+			"delete":   toDelete,
+			"skipped":  skipped,
+		}, "%s", &sb)
+		return
+	}
+
+	// Transfer files
+	var transferred []string
+	if upload {
+		credentials := sync.OnceValues(func() (*s3Credentials, error) {
+			return getStorageS3Credentials(projectID)
+		})
+
+		transferred, err = runStorageS3SyncActions(toTransfer, func(relPath string) error {
+			log.Printf("Uploading %s...", relPath)
+			_, _, err := storageS3UploadFile(target, credentials, containerName, prefix+relPath,
+				filepath.Join(localDir, filepath.FromSlash(relPath)), partSize)
+			return err
+		})
+	} else {
+		transferred, err = runStorageS3SyncActions(toTransfer, func(relPath string) error {
+			log.Printf("Downloading %s...", relPath)
+			filePath, err := storageS3SyncLocalPath(localDir, relPath)
+			if err != nil {
+				return err
+			}
+			if err := os.MkdirAll(filepath.Dir(filePath), 0o755); err != nil {
+				return err
+			}
+
+			if _, _, err := storageS3Download(target, containerName, prefix+relPath, filePath, partSize); err != nil {
+				return err
+			}
+
+			// Align modification time on the object so that next syncs can compare them
+			if modTime := sourceEntries[relPath].modTime; !modTime.IsZero() {
+				return os.Chtimes(filePath, modTime, modTime)
+			}
+			return nil
+		})
+	}
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to transfer %d file(s): %s", len(toTransfer)-len(transferred), err)
+		return
+	}
+
+	// Delete extraneous files
+	var deleted []string
+	if len(toDelete) > 0 {
+		if upload {
+			deleted, err = deleteStorageS3SyncObjects(target.apiURL, prefix, toDelete)
+		} else {
+			deleted, err = runStorageS3SyncActions(toDelete, func(relPath string) error {
+				log.Printf("Deleting %s...", relPath)
+				return os.Remove(filepath.Join(localDir, filepath.FromSlash(relPath)))
+			})
+		}
+		if err != nil {
+			display.OutputError(&flags.OutputFormatConfig, "failed to delete extraneous files: %s", err)
+			return
+		}
+	}
+
+	display.OutputInfo(&flags.OutputFormatConfig, map[string]any{
+		"transferred": transferred,
+		"deleted":     deleted,
+		"skipped":     skipped,
+	}, "✅ Sync done: %d file(s) transferred, %d deleted, %d already up to date", len(transferred), len(deleted), skipped)
+}
+
+// deleteStorageS3SyncObjects deletes the given objects in batches of 1000
+func deleteStorageS3SyncObjects(apiURL, prefix string, relPaths []string) ([]string, error) {
+	const batchSize = 1000
+
+	var deleted []string
+	for start := 0; start < len(relPaths); start += batchSize {
+		batch := relPaths[start:min(start+batchSize, len(relPaths))]
+
+		var objectsToDelete []map[string]any
+		for _, relPath := range batch {
+			objectsToDelete = append(objectsToDelete, map[string]any{"key": prefix + relPath})
+		}
+
+		log.Printf("Deleting %d objects...", len(objectsToDelete))
+		if err := httpLib.Client.Post(apiURL+"/bulkDeleteObjects", map[string]any{
+			"objects": objectsToDelete,
+		}, nil); err != nil {
+			return deleted, err
+		}
+		deleted = append(deleted, batch...)
+	}
+
+	return deleted, nil
+}
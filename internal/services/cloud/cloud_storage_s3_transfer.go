@@ -0,0 +1,978 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cloud
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ovh/ovhcloud-cli/internal/display"
+	"github.com/ovh/ovhcloud-cli/internal/flags"
+	httpLib "github.com/ovh/ovhcloud-cli/internal/http"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// Validity of the presigned URLs generated for transfers
+	storageS3TransferURLExpiry = 3600
+
+	// Minimum part size accepted by S3 for multipart uploads (except for the last part)
+	storageS3MinPartSize = 5 * 1024 * 1024
+)
+
+var (
+	// StorageS3TransferParams holds the options of object upload and download.
+	StorageS3TransferParams struct {
+		PartSize     int
+		Parallel     int
+		Retries      int
+		StorageClass string
+		VersionId    string
+		S3User       string
+		NoResume     bool
+	}
+
+	// errStorageS3TransferNotRetryable wraps errors that won't be fixed by retrying the request
+	errStorageS3TransferNotRetryable = errors.New("request cannot be retried")
+)
+
+// s3Credentials holds the access and secret keys used to sign S3 requests
+type s3Credentials struct {
+	Access string
+	Secret string
+}
+
+// storageS3Target describes the S3 endpoint of a storage container
+type storageS3Target struct {
+	apiURL   string
+	endpoint *url.URL
+	region   string
+}
+
+// storageS3TransferJournal is persisted during transfers so that an interrupted
+// transfer can be resumed where it stopped
+type storageS3TransferJournal struct {
+	path string
+	mu   sync.Mutex
+
+	UploadId string         `json:"uploadId,omitempty"`
+	ETag     string         `json:"etag,omitempty"`
+	Size     int64          `json:"size"`
+	ModTime  int64          `json:"modTime,omitempty"`
+	PartSize int64          `json:"partSize"`
+	Parts    map[int]string `json:"parts"`
+}
+
+func (j *storageS3TransferJournal) save() error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	data, err := json.Marshal(j)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(j.path, data, 0o600)
+}
+
+func (j *storageS3TransferJournal) completePart(number int, etag string) error {
+	j.mu.Lock()
+	j.Parts[number] = etag
+	j.mu.Unlock()
+
+	return j.save()
+}
+
+func (j *storageS3TransferJournal) isPartCompleted(number int) bool {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	_, ok := j.Parts[number]
+	return ok
+}
+
+func (j *storageS3TransferJournal) remove() {
+	if err := os.Remove(j.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		log.Printf("failed to remove transfer journal %s: %s", j.path, err)
+	}
+}
+
+// loadStorageS3TransferJournal returns the journal of a previous transfer
+// matching the given parameters, or a fresh one.
+func loadStorageS3TransferJournal(kind, container, key, file string) (*storageS3TransferJournal, error) {
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		return nil, fmt.Errorf("failed to locate cache directory: %w", err)
+	}
+
+	dir := filepath.Join(cacheDir, "ovhcloud-cli", "transfers")
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create transfers directory: %w", err)
+	}
+
+	absFile, err := filepath.Abs(file)
+	if err != nil {
+		return nil, err
+	}
+
+	id := sha256.Sum256([]byte(strings.Join([]string{kind, container, key, absFile}, "\n")))
+	journal := &storageS3TransferJournal{
+		path:  filepath.Join(dir, hex.EncodeToString(id[:])+".json"),
+		Parts: map[int]string{},
+	}
+
+	if StorageS3TransferParams.NoResume {
+		return journal, nil
+	}
+
+	data, err := os.ReadFile(journal.path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return journal, nil
+		}
+		return nil, fmt.Errorf("failed to read transfer journal: %w", err)
+	}
+
+	if err := json.Unmarshal(data, journal); err != nil {
+		log.Printf("ignoring invalid transfer journal %s: %s", journal.path, err)
+		journal.UploadId, journal.ETag, journal.Parts = "", "", map[int]string{}
+	}
+	if journal.Parts == nil {
+		journal.Parts = map[int]string{}
+	}
+
+	return journal, nil
+}
+
+// locateStorageS3Target returns the API and S3 endpoints of the given container
+func locateStorageS3Target(projectID, containerName string) (*storageS3Target, error) {
+	foundURL, container, err := locateStorageS3Container(projectID, containerName)
+	if err != nil {
+		return nil, err
+	}
+
+	target := &storageS3Target{apiURL: foundURL}
+	if region, ok := container["region"].(string); ok {
+		target.region = strings.ToLower(region)
+	}
+	if virtualHost, ok := container["virtualHost"].(string); ok && virtualHost != "" {
+		endpoint, err := url.Parse(virtualHost)
+		if err != nil {
+			return nil, fmt.Errorf("invalid virtual host %q for container %s: %w", virtualHost, containerName, err)
+		}
+		target.endpoint = endpoint
+	}
+
+	return target, nil
+}
+
+// presignStorageS3Object generates a presigned URL for the given object
+func presignStorageS3Object(target *storageS3Target, method, key string) (string, http.Header, error) {
+	body := map[string]any{
+		"expire": storageS3TransferURLExpiry,
+		"method": method,
+		"object": key,
+	}
+	if method == http.MethodPut && StorageS3TransferParams.StorageClass != "" {
+		body["storageClass"] = StorageS3TransferParams.StorageClass
+	}
+	if method == http.MethodGet && StorageS3TransferParams.VersionId != "" {
+		body["versionId"] = StorageS3TransferParams.VersionId
+	}
+
+	var response struct {
+		URL           string            `json:"url"`
+		SignedHeaders map[string]string `json:"signedHeaders"`
+	}
+	if err := httpLib.Client.Post(target.apiURL+"/presign", body, &response); err != nil {
+		return "", nil, fmt.Errorf("failed to generate presigned URL: %w", err)
+	}
+
+	headers := make(http.Header)
+	for key, value := range response.SignedHeaders {
+		// Host header is computed from the URL
+		if !strings.EqualFold(key, "host") {
+			headers.Set(key, value)
+		}
+	}
+
+	return response.URL, headers, nil
+}
+
+// fetchStorageS3UserCredentials returns the S3 credentials of the given user,
+// creating them if the user doesn't have any yet
+func fetchStorageS3UserCredentials(projectID, userID string) (*s3Credentials, error) {
+	endpoint := fmt.Sprintf("/v1/cloud/project/%s/user/%s/s3Credentials", projectID, url.PathEscape(userID))
+
+	var existing []struct {
+		Access string `json:"access"`
+	}
+	if err := httpLib.Client.Get(endpoint, &existing); err != nil {
+		return nil, fmt.Errorf("failed to list S3 credentials of user %s: %w", userID, err)
+	}
+
+	var access string
+	if len(existing) > 0 {
+		access = existing[0].Access
+	} else {
+		var created struct {
+			Access string `json:"access"`
+		}
+		if err := httpLib.Client.Post(endpoint, nil, &created); err != nil {
+			return nil, fmt.Errorf("failed to create S3 credentials for user %s: %w", userID, err)
+		}
+		access = created.Access
+	}
+
+	var secret struct {
+		Secret string `json:"secret"`
+	}
+	if err := httpLib.Client.Post(endpoint+"/"+url.PathEscape(access)+"/secret", nil, &secret); err != nil {
+		return nil, fmt.Errorf("failed to get S3 credentials secret: %w", err)
+	}
+
+	return &s3Credentials{Access: access, Secret: secret.Secret}, nil
+}
+
+// getStorageS3Credentials returns the credentials to use for signed S3 requests, either
+// from the user given with --s3-user or from the AWS_* environment variables
+func getStorageS3Credentials(projectID string) (*s3Credentials, error) {
+	if StorageS3TransferParams.S3User != "" {
+		return fetchStorageS3UserCredentials(projectID, StorageS3TransferParams.S3User)
+	}
+
+	access, secret := os.Getenv("AWS_ACCESS_KEY_ID"), os.Getenv("AWS_SECRET_ACCESS_KEY")
+	if access == "" || secret == "" {
+		return nil, errors.New("multipart uploads require S3 credentials, use --s3-user or define AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY")
+	}
+
+	return &s3Credentials{Access: access, Secret: secret}, nil
+}
+
+// s3URIEncode encodes a string as expected by AWS signature v4
+func s3URIEncode(value string, encodeSlash bool) string {
+	var sb strings.Builder
+	for _, b := range []byte(value) {
+		switch {
+		case b >= 'A' && b <= 'Z', b >= 'a' && b <= 'z', b >= '0' && b <= '9',
+			b == '-', b == '_', b == '.', b == '~':
+			sb.WriteByte(b)
+		case b == '/' && !encodeSlash:
+			sb.WriteByte(b)
+		default:
+			fmt.Fprintf(&sb, "%%%02X", b)
+		}
+	}
+	return sb.String()
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+// newSignedS3Request builds a request on the given object signed with AWS signature v4.
+// The payload is not signed, its integrity is checked using the Content-MD5 header.
+func newSignedS3Request(target *storageS3Target, creds *s3Credentials, method, key string, query url.Values, body io.Reader, contentLength int64, contentMD5 string) (*http.Request, error) {
+	if target.endpoint == nil {
+		return nil, errors.New("storage container has no S3 endpoint")
+	}
+
+	// Canonical query string, also used as the actual request query
+	queryKeys := make([]string, 0, len(query))
+	for k := range query {
+		queryKeys = append(queryKeys, k)
+	}
+	sort.Strings(queryKeys)
+	var queryParts []string
+	for _, k := range queryKeys {
+		queryParts = append(queryParts, s3URIEncode(k, true)+"="+s3URIEncode(query.Get(k), true))
+	}
+
+	u := *target.endpoint
+	u.Path = "/" + key
+	u.RawPath = "/" + s3URIEncode(key, false)
+	u.RawQuery = strings.Join(queryParts, "&")
+
+	req, err := http.NewRequest(method, u.String(), body)
+	if err != nil {
+		return nil, err
+	}
+	req.ContentLength = contentLength
+
+	now := time.Now().UTC()
+	amzDate := now.Format("20060102T150405Z")
+	shortDate := now.Format("20060102")
+
+	req.Header.Set("x-amz-date", amzDate)
+	req.Header.Set("x-amz-content-sha256", "UNSIGNED-PAYLOAD")
+	if contentMD5 != "" {
+		req.Header.Set("Content-MD5", contentMD5)
+	}
+	if method == http.MethodPost && query.Has("uploads") && StorageS3TransferParams.StorageClass != "" {
+		req.Header.Set("x-amz-storage-class", StorageS3TransferParams.StorageClass)
+	}
+
+	headers := map[string]string{"host": u.Host}
+	for name := range req.Header {
+		headers[strings.ToLower(name)] = strings.TrimSpace(req.Header.Get(name))
+	}
+	headerNames := make([]string, 0, len(headers))
+	for name := range headers {
+		headerNames = append(headerNames, name)
+	}
+	sort.Strings(headerNames)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range headerNames {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(headerNames, ";")
+
+	canonicalRequest := strings.Join([]string{
+		method,
+		u.EscapedPath(),
+		u.RawQuery,
+		canonicalHeaders.String(),
+		signedHeaders,
+		"UNSIGNED-PAYLOAD",
+	}, "\n")
+
+	scope := shortDate + "/" + target.region + "/s3/aws4_request"
+	canonicalHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hex.EncodeToString(canonicalHash[:])
+
+	signingKey := hmacSHA256([]byte("AWS4"+creds.Secret), shortDate)
+	signingKey = hmacSHA256(signingKey, target.region)
+	signingKey = hmacSHA256(signingKey, "s3")
+	signingKey = hmacSHA256(signingKey, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		creds.Access, scope, signedHeaders, signature))
+
+	return req, nil
+}
+
+// doStorageS3Request executes the given request and returns an error if
+// the response status is not one of the expected ones
+func doStorageS3Request(req *http.Request, expectedStatus ...int) (*http.Response, error) {
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, status := range expectedStatus {
+		if resp.StatusCode == status {
+			return resp, nil
+		}
+	}
+
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+	err = fmt.Errorf("unexpected response status %s: %s", resp.Status, strings.TrimSpace(string(body)))
+
+	// Client errors won't be fixed by a retry, except timeouts and throttling
+	if resp.StatusCode >= 400 && resp.StatusCode < 500 &&
+		resp.StatusCode != http.StatusRequestTimeout && resp.StatusCode != http.StatusTooManyRequests {
+		err = fmt.Errorf("%w: %w", errStorageS3TransferNotRetryable, err)
+	}
+
+	return nil, err
+}
+
+// withStorageS3Retries runs the given function until it succeeds, with an exponential backoff
+func withStorageS3Retries(description string, fn func() error) error {
+	var err error
+	for attempt := 0; attempt <= StorageS3TransferParams.Retries; attempt++ {
+		if attempt > 0 {
+			delay := time.Duration(1<<(attempt-1)) * time.Second
+			log.Printf("%s failed (%s), retrying in %s...", description, err, delay)
+			time.Sleep(delay)
+		}
+
+		if err = fn(); err == nil || errors.Is(err, errStorageS3TransferNotRetryable) {
+			return err
+		}
+	}
+
+	return fmt.Errorf("%s failed after %d attempts: %w", description, StorageS3TransferParams.Retries+1, err)
+}
+
+// runStorageS3Parts calls the given function for each part not yet completed,
+// using the configured level of parallelism
+func runStorageS3Parts(partsCount int, journal *storageS3TransferJournal, fn func(number int) error) error {
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+		done     = len(journal.Parts)
+	)
+
+	failed := func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return firstErr != nil
+	}
+
+	numbers := make(chan int)
+	for range max(StorageS3TransferParams.Parallel, 1) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for number := range numbers {
+				err := fn(number)
+
+				mu.Lock()
+				if err != nil {
+					if firstErr == nil {
+						firstErr = err
+					}
+				} else {
+					done++
+					log.Printf("Part %d transferred (%d/%d done)", number, done, partsCount)
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	for number := 1; number <= partsCount; number++ {
+		// Stop scheduling new parts after the first failure
+		if failed() {
+			break
+		}
+		if !journal.isPartCompleted(number) {
+			numbers <- number
+		}
+	}
+	close(numbers)
+	wg.Wait()
+
+	return firstErr
+}
+
+// fileMD5 computes the MD5 checksum of the given section of a file
+func fileMD5(file *os.File, offset, size int64) ([]byte, error) {
+	h := md5.New()
+	if _, err := io.Copy(h, io.NewSectionReader(file, offset, size)); err != nil {
+		return nil, err
+	}
+	return h.Sum(nil), nil
+}
+
+func StorageS3UploadObject(_ *cobra.Command, args []string) {
+	projectID, err := getConfiguredCloudProject()
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	containerName, key, filePath := args[0], args[1], args[2]
+
+	partSize := int64(StorageS3TransferParams.PartSize) * 1024 * 1024
+	if partSize < storageS3MinPartSize {
+		display.OutputError(&flags.OutputFormatConfig, "part size must be at least 5 MiB")
+		return
+	}
+
+	target, err := locateStorageS3Target(projectID, containerName)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	credentials := func() (*s3Credentials, error) {
+		return getStorageS3Credentials(projectID)
+	}
+
+	size, etag, err := storageS3UploadFile(target, credentials, containerName, key, filePath, partSize)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to upload file: %s", err)
+		return
+	}
+
+	display.OutputInfo(&flags.OutputFormatConfig, map[string]any{
+		"container": containerName,
+		"key":       key,
+		"size":      size,
+		"etag":      etag,
+	}, "✅ File %s uploaded successfully to %s/%s", filePath, containerName, key)
+}
+
+// storageS3UploadFile uploads the given file, in a single request if it fits in one part
+// or using a multipart upload otherwise. The credentials function is only called for
+// multipart uploads.
+func storageS3UploadFile(target *storageS3Target, credentials func() (*s3Credentials, error), containerName, key, filePath string, partSize int64) (int64, string, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return 0, "", fmt.Errorf("failed to open file: %w", err)
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return 0, "", fmt.Errorf("failed to read file information: %w", err)
+	}
+
+	var etag string
+	if info.Size() <= partSize {
+		etag, err = storageS3UploadSinglePart(target, key, file, info.Size())
+	} else {
+		var creds *s3Credentials
+		if creds, err = credentials(); err == nil {
+			etag, err = storageS3UploadMultipart(target, creds, containerName, key, file, info, partSize)
+		}
+	}
+	if err != nil {
+		return 0, "", err
+	}
+
+	return info.Size(), etag, nil
+}
+
+// storageS3UploadSinglePart uploads the given file in a single request using a presigned URL
+func storageS3UploadSinglePart(target *storageS3Target, key string, file *os.File, size int64) (string, error) {
+	checksum, err := fileMD5(file, 0, size)
+	if err != nil {
+		return "", fmt.Errorf("failed to compute checksum: %w", err)
+	}
+	expectedETag := hex.EncodeToString(checksum)
+
+	var etag string
+	err = withStorageS3Retries("upload", func() error {
+		presignedURL, headers, err := presignStorageS3Object(target, http.MethodPut, key)
+		if err != nil {
+			return err
+		}
+
+		req, err := http.NewRequest(http.MethodPut, presignedURL, io.NewSectionReader(file, 0, size))
+		if err != nil {
+			return fmt.Errorf("%w: %w", errStorageS3TransferNotRetryable, err)
+		}
+		req.ContentLength = size
+		req.Header = headers
+
+		resp, err := doStorageS3Request(req, http.StatusOK)
+		if err != nil {
+			return err
+		}
+		resp.Body.Close()
+
+		etag = strings.Trim(resp.Header.Get("ETag"), `"`)
+		if etag != "" && etag != expectedETag {
+			return fmt.Errorf("checksum mismatch: expected %s, got %s", expectedETag, etag)
+		}
+		return nil
+	})
+
+	return etag, err
+}
+
+// storageS3UploadMultipart uploads the given file in several parts, recording
+// uploaded parts in a journal so that the upload can be resumed
+func storageS3UploadMultipart(target *storageS3Target, creds *s3Credentials, containerName, key string, file *os.File, info os.FileInfo, partSize int64) (string, error) {
+	journal, err := loadStorageS3TransferJournal("upload", containerName, key, file.Name())
+	if err != nil {
+		return "", err
+	}
+
+	size := info.Size()
+	if journal.UploadId != "" &&
+		(journal.Size != size || journal.ModTime != info.ModTime().UnixNano() || journal.PartSize != partSize) {
+		log.Printf("File changed since the previous upload attempt, starting over")
+		journal.UploadId, journal.Parts = "", map[int]string{}
+	}
+
+	if journal.UploadId == "" {
+		var initiated struct {
+			UploadId string `xml:"UploadId"`
+		}
+		err := withStorageS3Retries("multipart upload initialization", func() error {
+			req, err := newSignedS3Request(target, creds, http.MethodPost, key, url.Values{"uploads": {""}}, nil, 0, "")
+			if err != nil {
+				return fmt.Errorf("%w: %w", errStorageS3TransferNotRetryable, err)
+			}
+			resp, err := doStorageS3Request(req, http.StatusOK)
+			if err != nil {
+				return err
+			}
+			defer resp.Body.Close()
+			return xml.NewDecoder(resp.Body).Decode(&initiated)
+		})
+		if err != nil {
+			return "", err
+		}
+
+		journal.UploadId = initiated.UploadId
+		journal.Size = size
+		journal.ModTime = info.ModTime().UnixNano()
+		journal.PartSize = partSize
+		if err := journal.save(); err != nil {
+			return "", fmt.Errorf("failed to write transfer journal: %w", err)
+		}
+	} else {
+		log.Printf("Resuming upload, %d parts already uploaded", len(journal.Parts))
+	}
+
+	partsCount := int((size + partSize - 1) / partSize)
+	err = runStorageS3Parts(partsCount, journal, func(number int) error {
+		offset := int64(number-1) * partSize
+		length := min(partSize, size-offset)
+
+		checksum, err := fileMD5(file, offset, length)
+		if err != nil {
+			return fmt.Errorf("failed to compute checksum of part %d: %w", number, err)
+		}
+		expectedETag := hex.EncodeToString(checksum)
+
+		return withStorageS3Retries(fmt.Sprintf("upload of part %d", number), func() error {
+			query := url.Values{
+				"partNumber": {strconv.Itoa(number)},
+				"uploadId":   {journal.UploadId},
+			}
+			req, err := newSignedS3Request(target, creds, http.MethodPut, key, query,
+				io.NewSectionReader(file, offset, length), length, base64.StdEncoding.EncodeToString(checksum))
+			if err != nil {
+				return fmt.Errorf("%w: %w", errStorageS3TransferNotRetryable, err)
+			}
+
+			resp, err := doStorageS3Request(req, http.StatusOK)
+			if err != nil {
+				return err
+			}
+			resp.Body.Close()
+
+			etag := strings.Trim(resp.Header.Get("ETag"), `"`)
+			if etag != expectedETag {
+				return fmt.Errorf("checksum mismatch on part %d: expected %s, got %s", number, expectedETag, etag)
+			}
+
+			return journal.completePart(number, etag)
+		})
+	})
+	if err != nil {
+		return "", fmt.Errorf("%w (run the same command again to resume the upload)", err)
+	}
+
+	// Complete the upload with the list of uploaded parts
+	type completedPart struct {
+		PartNumber int    `xml:"PartNumber"`
+		ETag       string `xml:"ETag"`
+	}
+	completion := struct {
+		XMLName xml.Name        `xml:"CompleteMultipartUpload"`
+		Parts   []completedPart `xml:"Part"`
+	}{}
+	for number := 1; number <= partsCount; number++ {
+		completion.Parts = append(completion.Parts, completedPart{
+			PartNumber: number,
+			ETag:       `"` + journal.Parts[number] + `"`,
+		})
+	}
+	completionBody, err := xml.Marshal(completion)
+	if err != nil {
+		return "", err
+	}
+
+	var etag string
+	err = withStorageS3Retries("multipart upload completion", func() error {
+		req, err := newSignedS3Request(target, creds, http.MethodPost, key, url.Values{"uploadId": {journal.UploadId}},
+			bytes.NewReader(completionBody), int64(len(completionBody)), "")
+		if err != nil {
+			return fmt.Errorf("%w: %w", errStorageS3TransferNotRetryable, err)
+		}
+
+		resp, err := doStorageS3Request(req, http.StatusOK)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+
+		// Completion errors can be returned with a 200 status code
+		var result struct {
+			XMLName xml.Name
+			ETag    string `xml:"ETag"`
+			Code    string `xml:"Code"`
+			Message string `xml:"Message"`
+		}
+		if err := xml.NewDecoder(resp.Body).Decode(&result); err != nil {
+			return fmt.Errorf("failed to parse completion response: %w", err)
+		}
+		if result.XMLName.Local == "Error" {
+			return fmt.Errorf("%s: %s", result.Code, result.Message)
+		}
+
+		etag = strings.Trim(result.ETag, `"`)
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	journal.remove()
+
+	return etag, nil
+}
+
+func StorageS3DownloadObject(_ *cobra.Command, args []string) {
+	projectID, err := getConfiguredCloudProject()
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	containerName, key, filePath := args[0], args[1], args[2]
+
+	partSize := int64(StorageS3TransferParams.PartSize) * 1024 * 1024
+	if partSize <= 0 {
+		display.OutputError(&flags.OutputFormatConfig, "part size must be positive")
+		return
+	}
+
+	target, err := locateStorageS3Target(projectID, containerName)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	size, etag, err := storageS3Download(target, containerName, key, filePath, partSize)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to download object: %s", err)
+		return
+	}
+
+	display.OutputInfo(&flags.OutputFormatConfig, map[string]any{
+		"container": containerName,
+		"key":       key,
+		"size":      size,
+		"etag":      etag,
+		"file":      filePath,
+	}, "✅ Object %s/%s downloaded successfully to %s", containerName, key, filePath)
+}
+
+// storageS3DownloadURL holds a presigned URL that is renewed before it expires
+type storageS3DownloadURL struct {
+	mu        sync.Mutex
+	target    *storageS3Target
+	key       string
+	url       string
+	headers   http.Header
+	expiresAt time.Time
+}
+
+func (d *storageS3DownloadURL) newRequest() (*http.Request, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.url == "" || time.Now().After(d.expiresAt) {
+		presignedURL, headers, err := presignStorageS3Object(d.target, http.MethodGet, d.key)
+		if err != nil {
+			return nil, err
+		}
+		d.url, d.headers = presignedURL, headers
+		d.expiresAt = time.Now().Add(storageS3TransferURLExpiry * time.Second / 2)
+	}
+
+	req, err := http.NewRequest(http.MethodGet, d.url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errStorageS3TransferNotRetryable, err)
+	}
+	req.Header = d.headers.Clone()
+
+	return req, nil
+}
+
+// storageS3Download downloads the given object into a temporary file using
+// parallel ranged requests, then moves it to its final location
+func storageS3Download(target *storageS3Target, containerName, key, filePath string, partSize int64) (int64, string, error) {
+	source := &storageS3DownloadURL{target: target, key: key}
+	tmpPath := filePath + ".part"
+
+	// Fetch object size and ETag with a first ranged request
+	var (
+		size      int64
+		etag      string
+		fullyRead bool
+	)
+	err := withStorageS3Retries("download", func() error {
+		req, err := source.newRequest()
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Range", "bytes=0-0")
+
+		resp, err := doStorageS3Request(req, http.StatusOK, http.StatusPartialContent, http.StatusRequestedRangeNotSatisfiable)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+
+		etag = strings.Trim(resp.Header.Get("ETag"), `"`)
+
+		// No range can be satisfied on an empty object
+		if resp.StatusCode == http.StatusRequestedRangeNotSatisfiable {
+			if contentRange := resp.Header.Get("Content-Range"); contentRange != "" && !strings.HasSuffix(contentRange, "/0") {
+				return fmt.Errorf("%w: unexpected Content-Range header %q", errStorageS3TransferNotRetryable, contentRange)
+			}
+			if err := os.WriteFile(tmpPath, nil, 0o644); err != nil {
+				return fmt.Errorf("%w: %w", errStorageS3TransferNotRetryable, err)
+			}
+			size, etag, fullyRead = 0, "", true
+			return nil
+		}
+
+		// Range requests not supported, write the whole body directly
+		if resp.StatusCode == http.StatusOK {
+			out, err := os.Create(tmpPath)
+			if err != nil {
+				return fmt.Errorf("%w: %w", errStorageS3TransferNotRetryable, err)
+			}
+			defer out.Close()
+
+			size, err = io.Copy(out, resp.Body)
+			if err != nil {
+				return err
+			}
+			fullyRead = true
+			return out.Close()
+		}
+
+		contentRange := resp.Header.Get("Content-Range")
+		total := contentRange[strings.LastIndex(contentRange, "/")+1:]
+		size, err = strconv.ParseInt(total, 10, 64)
+		if err != nil {
+			return fmt.Errorf("%w: invalid Content-Range header %q", errStorageS3TransferNotRetryable, contentRange)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, "", err
+	}
+
+	if !fullyRead {
+		if err := storageS3DownloadParts(source, containerName, key, filePath, tmpPath, size, etag, partSize); err != nil {
+			return 0, "", err
+		}
+	}
+
+	// Objects uploaded in a single part have their MD5 checksum as ETag
+	if len(etag) == md5.Size*2 && !strings.Contains(etag, "-") {
+		file, err := os.Open(tmpPath)
+		if err != nil {
+			return 0, "", err
+		}
+		checksum, err := fileMD5(file, 0, size)
+		file.Close()
+		if err != nil {
+			return 0, "", fmt.Errorf("failed to compute checksum: %w", err)
+		}
+		if hex.EncodeToString(checksum) != etag {
+			os.Remove(tmpPath)
+			return 0, "", fmt.Errorf("checksum mismatch: expected %s, got %s", etag, hex.EncodeToString(checksum))
+		}
+	}
+
+	if err := os.Rename(tmpPath, filePath); err != nil {
+		return 0, "", fmt.Errorf("failed to move downloaded file: %w", err)
+	}
+
+	return size, etag, nil
+}
+
+func storageS3DownloadParts(source *storageS3DownloadURL, containerName, key, filePath, tmpPath string, size int64, etag string, partSize int64) error {
+	journal, err := loadStorageS3TransferJournal("download", containerName, key, filePath)
+	if err != nil {
+		return err
+	}
+
+	// Restart from scratch if the object changed or the partial file is missing
+	if _, statErr := os.Stat(tmpPath); statErr != nil ||
+		journal.ETag != etag || journal.Size != size || journal.PartSize != partSize {
+		journal.Parts = map[int]string{}
+		if err := os.Remove(tmpPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	} else if len(journal.Parts) > 0 {
+		log.Printf("Resuming download, %d parts already downloaded", len(journal.Parts))
+	}
+
+	journal.ETag, journal.Size, journal.PartSize = etag, size, partSize
+	if err := journal.save(); err != nil {
+		return fmt.Errorf("failed to write transfer journal: %w", err)
+	}
+
+	out, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+	if err := out.Truncate(size); err != nil {
+		return err
+	}
+
+	partsCount := int((size + partSize - 1) / partSize)
+	err = runStorageS3Parts(partsCount, journal, func(number int) error {
+		offset := int64(number-1) * partSize
+		length := min(partSize, size-offset)
+
+		return withStorageS3Retries(fmt.Sprintf("download of part %d", number), func() error {
+			req, err := source.newRequest()
+			if err != nil {
+				return err
+			}
+			req.Header.Set("Range", fmt.Sprintf("bytes=%d-%d", offset, offset+length-1))
+			if etag != "" {
+				req.Header.Set("If-Match", `"`+etag+`"`)
+			}
+
+			resp, err := doStorageS3Request(req, http.StatusPartialContent)
+			if err != nil {
+				return err
+			}
+			defer resp.Body.Close()
+
+			written, err := io.Copy(io.NewOffsetWriter(out, offset), resp.Body)
+			if err != nil {
+				return err
+			}
+			if written != length {
+				return fmt.Errorf("incomplete part %d: received %d bytes out of %d", number, written, length)
+			}
+
+			return journal.completePart(number, "")
+		})
+	})
+	if err != nil {
+		return fmt.Errorf("%w (run the same command again to resume the download)", err)
+	}
+
+	if err := out.Close(); err != nil {
+		return err
+	}
+	journal.remove()
+
+	return nil
+}
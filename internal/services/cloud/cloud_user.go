@@ -12,6 +12,9 @@ import (
 	"log"
 	"net/url"
 	"os"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/ovh/ovhcloud-cli/internal/assets"
 	"github.com/ovh/ovhcloud-cli/internal/display"
@@ -45,6 +48,19 @@ var (
 	StorageS3ContainerPolicySpec struct {
 		Policy string `json:"policy,omitempty"`
 	}
+
+	// UserS3CredentialsRotationParams holds the options of the S3 credentials rotation.
+	UserS3CredentialsRotationParams struct {
+		OutputFile       string
+		OutputFileFormat string
+		Profile          string
+		WaitConfirmation bool
+		GracePeriod      time.Duration
+		KeepOld          bool
+	}
+
+	// Formats of the file in which rotated S3 credentials can be written
+	s3CredentialsFileFormats = []string{"env", "json", "aws-profile"}
 )
 
 func ListCloudUsers(_ *cobra.Command, _ []string) {
@@ -230,3 +246,190 @@ func GetUserS3Policy(_ *cobra.Command, args []string) {
 
 	common.ManageObjectRequest(fmt.Sprintf("/v1/cloud/project/%s/user/%s/policy", projectID, args[0]), "", "")
 }
+
+// writeS3CredentialsFile writes the given credentials to a file in the requested format
+func writeS3CredentialsFile(path, format, profile, access, secret string) error {
+	var content []byte
+
+	switch format {
+	case "env":
+		content = []byte(fmt.Sprintf("AWS_ACCESS_KEY_ID=%s\nAWS_SECRET_ACCESS_KEY=%s\n", access, secret))
+	case "json":
+		var err error
+		content, err = json.MarshalIndent(map[string]string{
+			"access": access,
+			"secret": secret,
+		}, "", "  ")
+		if err != nil {
+			return err
+		}
+		content = append(content, '\n')
+	case "aws-profile":
+		// Replace the profile section in the existing file, keeping the other ones
+		existing, err := os.ReadFile(path)
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		content = []byte(upsertAWSCredentialsProfile(string(existing), profile, access, secret))
+	default:
+		return fmt.Errorf("invalid file format %q, expected one of %s", format, strings.Join(s3CredentialsFileFormats, ", "))
+	}
+
+	return os.WriteFile(path, content, 0o600)
+}
+
+// upsertAWSCredentialsProfile replaces or adds the given profile section in the
+// content of an AWS credentials file
+func upsertAWSCredentialsProfile(content, profile, access, secret string) string {
+	var (
+		lines     []string
+		inProfile bool
+		found     bool
+	)
+
+	section := []string{
+		"[" + profile + "]",
+		"aws_access_key_id = " + access,
+		"aws_secret_access_key = " + secret,
+	}
+
+	for _, line := range strings.Split(strings.TrimRight(content, "\n"), "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "[") && strings.HasSuffix(trimmed, "]") {
+			// Keep sections separated by an empty line
+			if len(lines) > 0 && lines[len(lines)-1] != "" {
+				lines = append(lines, "")
+			}
+
+			inProfile = strings.TrimSpace(trimmed[1:len(trimmed)-1]) == profile
+			if inProfile {
+				found = true
+				lines = append(lines, section...)
+				continue
+			}
+		}
+		if !inProfile && (line != "" || len(lines) > 0) {
+			lines = append(lines, line)
+		}
+	}
+
+	if !found {
+		if len(lines) > 0 {
+			lines = append(lines, "")
+		}
+		lines = append(lines, section...)
+	}
+
+	return strings.Join(lines, "\n") + "\n"
+}
+
+func RotateUserS3Credentials(_ *cobra.Command, args []string) {
+	projectID, err := getConfiguredCloudProject()
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	// Check the file format before creating credentials that could not be written
+	if UserS3CredentialsRotationParams.OutputFile != "" && !slices.Contains(s3CredentialsFileFormats, UserS3CredentialsRotationParams.OutputFileFormat) {
+		display.OutputError(&flags.OutputFormatConfig, "invalid file format %q, expected one of %s",
+			UserS3CredentialsRotationParams.OutputFileFormat, strings.Join(s3CredentialsFileFormats, ", "))
+		return
+	}
+
+	userID := args[0]
+	endpoint := fmt.Sprintf("/v1/cloud/project/%s/user/%s/s3Credentials", projectID, url.PathEscape(userID))
+
+	// Fetch the existing credentials that will be revoked
+	var previous []struct {
+		Access string `json:"access"`
+	}
+	if err := httpLib.Client.Get(endpoint, &previous); err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to list S3 credentials: %s", err)
+		return
+	}
+
+	// Create new credentials
+	var created struct {
+		Access string `json:"access"`
+		Secret string `json:"secret"`
+	}
+	if err := httpLib.Client.Post(endpoint, nil, &created); err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to create S3 credentials: %s", err)
+		return
+	}
+
+	details := map[string]any{
+		"userId": userID,
+		"access": created.Access,
+	}
+
+	if UserS3CredentialsRotationParams.OutputFile != "" {
+		if err := writeS3CredentialsFile(
+			UserS3CredentialsRotationParams.OutputFile,
+			UserS3CredentialsRotationParams.OutputFileFormat,
+			UserS3CredentialsRotationParams.Profile,
+			created.Access,
+			created.Secret,
+		); err != nil {
+			display.OutputError(&flags.OutputFormatConfig, "new credentials %s created but could not be written: %s", created.Access, err)
+			return
+		}
+		log.Printf("New credentials %s written to %s", created.Access, UserS3CredentialsRotationParams.OutputFile)
+	} else {
+		details["secret"] = created.Secret
+	}
+
+	// Describes the new credentials in the messages, as the secret
+	// cannot be fetched again once the command has exited
+	newCredentials := created.Access
+	if UserS3CredentialsRotationParams.OutputFile == "" {
+		newCredentials = fmt.Sprintf("%s (secret key: %s)", created.Access, created.Secret)
+	}
+
+	// Let the new credentials be deployed before revoking the previous ones,
+	// so they must be known before waiting
+	waiting := UserS3CredentialsRotationParams.WaitConfirmation || UserS3CredentialsRotationParams.GracePeriod > 0
+	if waiting && UserS3CredentialsRotationParams.OutputFile == "" {
+		fmt.Fprintf(os.Stderr, "New credentials created, access key: %s, secret key: %s\n", created.Access, created.Secret)
+	}
+	if UserS3CredentialsRotationParams.WaitConfirmation {
+		fmt.Fprintf(os.Stderr, "Press Enter once the new credentials are deployed to revoke the previous ones...")
+		if _, err := bufio.NewReader(os.Stdin).ReadString('\n'); err != nil {
+			display.OutputError(&flags.OutputFormatConfig, "new credentials %s created, but failed to read confirmation, previous credentials were kept: %s", newCredentials, err)
+			return
+		}
+	}
+	if UserS3CredentialsRotationParams.GracePeriod > 0 {
+		log.Printf("Waiting %s before revoking previous credentials...", UserS3CredentialsRotationParams.GracePeriod)
+		time.Sleep(UserS3CredentialsRotationParams.GracePeriod)
+	}
+
+	revoked := []string{}
+	if !UserS3CredentialsRotationParams.KeepOld {
+		var failures []string
+		for _, credentials := range previous {
+			if err := httpLib.Client.Delete(endpoint+"/"+url.PathEscape(credentials.Access), nil); err != nil {
+				failures = append(failures, fmt.Sprintf("%s (%s)", credentials.Access, err))
+				continue
+			}
+			revoked = append(revoked, credentials.Access)
+		}
+
+		if len(failures) > 0 {
+			display.OutputError(&flags.OutputFormatConfig, "new credentials %s created, but failed to revoke: %s", newCredentials, strings.Join(failures, ", "))
+			return
+		}
+	}
+	details["revoked"] = revoked
+
+	message := fmt.Sprintf("✅ S3 credentials of user %s rotated, new access key: %s", userID, created.Access)
+	if UserS3CredentialsRotationParams.OutputFile == "" {
+		message += fmt.Sprintf(", secret key: %s", created.Secret)
+	}
+	if len(revoked) > 0 {
+		message += fmt.Sprintf("\nRevoked keys: %s", strings.Join(revoked, ", "))
+	}
+
+	display.OutputInfo(&flags.OutputFormatConfig, details, "%s", message)
+}
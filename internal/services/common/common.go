@@ -46,6 +46,14 @@ func ManageListRequest(path, idField string, columnsToDisplay, filters []string)
 		return
 	}
 
+	if flags.ShowIAMTags {
+		columnsToDisplay, err = AddIAMTags(body, columnsToDisplay)
+		if err != nil {
+			display.OutputError(&flags.OutputFormatConfig, "%s", err)
+			return
+		}
+	}
+
 	body, err = filtersLib.FilterLines(body, filters)
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "failed to filter results: %s", err)
@@ -67,6 +75,14 @@ func ManageListRequestNoExpand(path string, columnsToDisplay, filters []string)
 		objects = append(objects, object.(map[string]any))
 	}
 
+	if flags.ShowIAMTags {
+		columnsToDisplay, err = AddIAMTags(objects, columnsToDisplay)
+		if err != nil {
+			display.OutputError(&flags.OutputFormatConfig, "%s", err)
+			return
+		}
+	}
+
 	objects, err = filtersLib.FilterLines(objects, filters)
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "failed to filter results: %s", err)
@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	httpLib "github.com/ovh/ovhcloud-cli/internal/http"
+)
+
+// IAMTagsField is the field added to listed objects when their IAM tags are displayed
+const IAMTagsField = "iamTags"
+
+// FormatIAMTags returns the given tags as a sorted list of key=value pairs
+func FormatIAMTags(tags map[string]any) string {
+	pairs := make([]string, 0, len(tags))
+	for key, value := range tags {
+		pairs = append(pairs, fmt.Sprintf("%s=%v", key, value))
+	}
+	slices.Sort(pairs)
+
+	return strings.Join(pairs, " ")
+}
+
+// objectIAMURN returns the URN of the given object, either from its "iam" property
+// (returned by most endpoints) or from its "urn" property (IAM resources)
+func objectIAMURN(object map[string]any) string {
+	if iam, ok := object["iam"].(map[string]any); ok {
+		if urn, ok := iam["urn"].(string); ok {
+			return urn
+		}
+	}
+
+	urn, _ := object["urn"].(string)
+	return urn
+}
+
+// AddIAMTags adds the IAM tags of the given objects in their IAMTagsField field,
+// joining them with the IAM resources of the account on their URN, and returns
+// the columns to display along with the tags
+func AddIAMTags(objects []map[string]any, columnsToDisplay []string) ([]string, error) {
+	resources, err := httpLib.FetchArray("/v2/iam/resource", "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch IAM resources: %w", err)
+	}
+
+	tagsByURN := make(map[string]map[string]any, len(resources))
+	for _, resource := range resources {
+		resource, ok := resource.(map[string]any)
+		if !ok {
+			continue
+		}
+		if tags, ok := resource["tags"].(map[string]any); ok {
+			tagsByURN[objectIAMURN(resource)] = tags
+		}
+	}
+
+	for _, object := range objects {
+		object[IAMTagsField] = FormatIAMTags(tagsByURN[objectIAMURN(object)])
+	}
+
+	return append(slices.Clone(columnsToDisplay), IAMTagsField+" tags"), nil
+}
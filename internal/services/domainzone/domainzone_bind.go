@@ -0,0 +1,480 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package domainzone
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+var (
+	// Record types that can be managed through the API
+	supportedRecordTypes = []string{
+		"A", "AAAA", "CAA", "CNAME", "DKIM", "DMARC", "DNAME", "HTTPS", "LOC", "MX", "NAPTR",
+		"NS", "PTR", "RP", "SPF", "SRV", "SSHFP", "SVCB", "TLSA", "TXT",
+	}
+
+	// Record types whose target contains domain names that may be relative to the origin,
+	// associated with the index of these names in the target fields
+	domainNameTargetFields = map[string][]int{
+		"CNAME": {0},
+		"DNAME": {0},
+		"NS":    {0},
+		"PTR":   {0},
+		"MX":    {1},
+		"SRV":   {3},
+	}
+
+	// Record types stored as TXT records in zone files
+	txtRecordTypes = []string{"TXT", "SPF", "DKIM", "DMARC"}
+)
+
+// zoneRecord is a DNS record of a zone, as defined in a zone file or returned by the API
+type zoneRecord struct {
+	ID        int64  `json:"id,omitempty"`
+	SubDomain string `json:"subDomain"`
+	FieldType string `json:"fieldType"`
+	Target    string `json:"target"`
+	TTL       int    `json:"ttl"`
+}
+
+// zoneFileLine is a logical line of a zone file, with its fields
+type zoneFileLine struct {
+	number       int
+	fields       []string
+	ownerOmitted bool
+}
+
+// splitZoneFile splits the given zone file content into logical lines, removing
+// comments and joining the lines enclosed in parentheses
+func splitZoneFile(content string) ([]zoneFileLine, error) {
+	var (
+		lines       []zoneFileLine
+		current     = zoneFileLine{number: 1}
+		field       strings.Builder
+		inQuotes    bool
+		inComment   bool
+		escaped     bool
+		parenDepth  int
+		lineNumber  = 1
+		startOfLine = true
+	)
+
+	endField := func() {
+		if field.Len() > 0 {
+			current.fields = append(current.fields, field.String())
+			field.Reset()
+		}
+	}
+
+	for _, r := range content {
+		switch {
+		case inComment:
+			if r != '\n' {
+				continue
+			}
+			inComment = false
+		case escaped:
+			field.WriteRune(r)
+			escaped = false
+			continue
+		case r == '\\':
+			field.WriteRune(r)
+			escaped = true
+			continue
+		case inQuotes:
+			field.WriteRune(r)
+			if r == '"' {
+				inQuotes = false
+			} else if r == '\n' {
+				lineNumber++
+			}
+			continue
+		}
+
+		switch {
+		case r == '\n':
+			lineNumber++
+			if parenDepth > 0 {
+				endField()
+				continue
+			}
+			endField()
+			if len(current.fields) > 0 {
+				lines = append(lines, current)
+			}
+			current = zoneFileLine{number: lineNumber}
+			startOfLine = true
+			continue
+		case r == ';':
+			inComment = true
+		case r == '"':
+			field.WriteRune(r)
+			inQuotes = true
+		case r == '(':
+			endField()
+			parenDepth++
+		case r == ')':
+			endField()
+			if parenDepth == 0 {
+				return nil, fmt.Errorf("line %d: unexpected closing parenthesis", lineNumber)
+			}
+			parenDepth--
+		case unicode.IsSpace(r):
+			if startOfLine && len(current.fields) == 0 && field.Len() == 0 {
+				current.ownerOmitted = true
+			}
+			endField()
+		default:
+			field.WriteRune(r)
+		}
+		startOfLine = false
+	}
+
+	switch {
+	case inQuotes:
+		return nil, fmt.Errorf("line %d: unterminated quoted string", current.number)
+	case parenDepth > 0:
+		return nil, fmt.Errorf("line %d: unterminated parenthesis", current.number)
+	}
+
+	endField()
+	if len(current.fields) > 0 {
+		lines = append(lines, current)
+	}
+
+	return lines, nil
+}
+
+// parseZoneTTL parses a TTL, either as a number of seconds or using BIND units (e.g. 1h30m)
+func parseZoneTTL(value string) (int, bool) {
+	if ttl, err := strconv.Atoi(value); err == nil {
+		return ttl, ttl >= 0
+	}
+
+	var (
+		total  int
+		number string
+	)
+	for _, r := range strings.ToLower(value) {
+		if unicode.IsDigit(r) {
+			number += string(r)
+			continue
+		}
+
+		n, err := strconv.Atoi(number)
+		if err != nil {
+			return 0, false
+		}
+		number = ""
+
+		switch r {
+		case 'w':
+			total += n * 7 * 24 * 3600
+		case 'd':
+			total += n * 24 * 3600
+		case 'h':
+			total += n * 3600
+		case 'm':
+			total += n * 60
+		case 's':
+			total += n
+		default:
+			return 0, false
+		}
+	}
+
+	return total, number == "" && value != ""
+}
+
+// absoluteZoneName returns the given name as a fully qualified name, relative names
+// being relative to the given origin
+func absoluteZoneName(name, origin string) string {
+	switch {
+	case name == "@":
+		return origin
+	case strings.HasSuffix(name, "."):
+		return name
+	default:
+		return name + "." + origin
+	}
+}
+
+// subDomainOf returns the sub-domain of the given fully qualified name in the given zone
+func subDomainOf(name, zone string) (string, error) {
+	name = strings.ToLower(name)
+	zone = strings.ToLower(strings.TrimSuffix(zone, ".")) + "."
+
+	switch {
+	case name == zone:
+		return "", nil
+	case strings.HasSuffix(name, "."+zone):
+		return strings.TrimSuffix(name, "."+zone), nil
+	default:
+		return "", fmt.Errorf("name %s is outside of zone %s", name, strings.TrimSuffix(zone, "."))
+	}
+}
+
+// parseZoneFile parses the records of the given zone file (RFC 1035 format).
+// SOA records and name servers of the zone apex are ignored, as they are
+// managed by OVHcloud.
+func parseZoneFile(content, zone string) ([]zoneRecord, error) {
+	lines, err := splitZoneFile(content)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		origin     = strings.TrimSuffix(zone, ".") + "."
+		defaultTTL = -1
+		lastTTL    = -1
+		lastOwner  string
+		records    []zoneRecord
+	)
+
+	for _, line := range lines {
+		fields := line.fields
+
+		// Directives
+		if strings.HasPrefix(fields[0], "$") && !line.ownerOmitted {
+			switch strings.ToUpper(fields[0]) {
+			case "$ORIGIN":
+				if len(fields) < 2 {
+					return nil, fmt.Errorf("line %d: missing value for $ORIGIN", line.number)
+				}
+				origin = absoluteZoneName(fields[1], origin)
+			case "$TTL":
+				ttl, ok := parseZoneTTL(safeField(fields, 1))
+				if !ok {
+					return nil, fmt.Errorf("line %d: invalid $TTL value %q", line.number, safeField(fields, 1))
+				}
+				defaultTTL = ttl
+			default:
+				return nil, fmt.Errorf("line %d: unsupported directive %s", line.number, fields[0])
+			}
+			continue
+		}
+
+		// Owner, omitted when the line starts with a blank
+		owner := lastOwner
+		if !line.ownerOmitted {
+			owner = absoluteZoneName(fields[0], origin)
+			fields = fields[1:]
+		}
+		if owner == "" {
+			return nil, fmt.Errorf("line %d: missing owner name", line.number)
+		}
+		lastOwner = owner
+
+		// TTL and class, in any order
+		ttl := defaultTTL
+		if ttl < 0 {
+			ttl = lastTTL
+		}
+		for range 2 {
+			if len(fields) == 0 {
+				break
+			}
+			if value, ok := parseZoneTTL(fields[0]); ok && unicode.IsDigit(rune(fields[0][0])) {
+				ttl = value
+				lastTTL = value
+				fields = fields[1:]
+			} else if class := strings.ToUpper(fields[0]); class == "IN" {
+				fields = fields[1:]
+			} else if class == "CH" || class == "HS" || class == "CS" {
+				return nil, fmt.Errorf("line %d: unsupported class %s", line.number, class)
+			}
+		}
+
+		if len(fields) < 2 {
+			return nil, fmt.Errorf("line %d: missing record type or data", line.number)
+		}
+		recordType := strings.ToUpper(fields[0])
+		data := fields[1:]
+
+		subDomain, err := subDomainOf(owner, zone)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line.number, err)
+		}
+
+		switch {
+		case recordType == "SOA":
+			continue
+		case recordType == "NS" && subDomain == "":
+			log.Printf("Ignoring NS record of the zone apex on line %d, name servers are managed by OVHcloud", line.number)
+			continue
+		case !slices.Contains(supportedRecordTypes, recordType):
+			return nil, fmt.Errorf("line %d: unsupported record type %s", line.number, recordType)
+		}
+
+		// Qualify relative domain names of the target
+		for _, index := range domainNameTargetFields[recordType] {
+			if index < len(data) {
+				data[index] = absoluteZoneName(data[index], origin)
+			}
+		}
+
+		records = append(records, zoneRecord{
+			SubDomain: subDomain,
+			FieldType: recordType,
+			Target:    strings.Join(data, " "),
+			TTL:       max(ttl, 0),
+		})
+	}
+
+	return records, nil
+}
+
+func safeField(fields []string, index int) string {
+	if index < len(fields) {
+		return fields[index]
+	}
+	return ""
+}
+
+// txtRecordValue returns the value of the given TXT target, without quotes
+func txtRecordValue(target string) string {
+	if !strings.HasPrefix(target, `"`) {
+		return target
+	}
+
+	var (
+		value    strings.Builder
+		inQuotes bool
+		escaped  bool
+	)
+	for _, r := range target {
+		switch {
+		case escaped:
+			value.WriteRune(r)
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == '"':
+			inQuotes = !inQuotes
+		case inQuotes:
+			value.WriteRune(r)
+		}
+	}
+
+	return value.String()
+}
+
+// normalizedRecord returns the representation of the given record used to compare it
+// with other records: TXT values are unquoted and domain names are fully qualified
+func normalizedRecord(record zoneRecord, zone string) (string, string) {
+	fieldType := record.FieldType
+	target := strings.Join(strings.Fields(record.Target), " ")
+
+	switch {
+	case slices.Contains(txtRecordTypes, fieldType):
+		fieldType = "TXT"
+		target = txtRecordValue(target)
+	case domainNameTargetFields[fieldType] != nil:
+		origin := strings.TrimSuffix(zone, ".") + "."
+		fields := strings.Fields(target)
+		for _, index := range domainNameTargetFields[fieldType] {
+			if index < len(fields) {
+				fields[index] = strings.ToLower(absoluteZoneName(fields[index], origin))
+			}
+		}
+		target = strings.Join(fields, " ")
+	}
+
+	return strings.ToLower(record.SubDomain) + " " + fieldType, target
+}
+
+// diffZoneRecords computes the changes needed to go from the current records to the wanted ones.
+// Records are matched by name, type and target, and the remaining records of a same name and
+// type are updated in place when replace is true. Current records that are not wanted are
+// only deleted when replace is true.
+func diffZoneRecords(current, wanted []zoneRecord, zone string, replace bool) (creates, updates, deletes []zoneRecord, err error) {
+	if replace && len(wanted) == 0 && len(current) > 0 {
+		return nil, nil, nil, errors.New("the zone file does not contain any record, refusing to delete all the records of the zone")
+	}
+
+	type group struct {
+		current []zoneRecord
+		wanted  []zoneRecord
+	}
+
+	var (
+		groups = make(map[string]*group)
+		keys   []string
+	)
+	getGroup := func(key string) *group {
+		if _, ok := groups[key]; !ok {
+			groups[key] = &group{}
+			keys = append(keys, key)
+		}
+		return groups[key]
+	}
+
+	for _, record := range current {
+		key, _ := normalizedRecord(record, zone)
+		g := getGroup(key)
+		g.current = append(g.current, record)
+	}
+
+	seen := make(map[string]bool)
+	for _, record := range wanted {
+		key, target := normalizedRecord(record, zone)
+		if seen[key+" "+target] {
+			return nil, nil, nil, fmt.Errorf("record %s %s %s is defined several times", record.SubDomain, record.FieldType, record.Target)
+		}
+		seen[key+" "+target] = true
+
+		g := getGroup(key)
+		g.wanted = append(g.wanted, record)
+	}
+
+	for _, key := range keys {
+		g := groups[key]
+
+		// Match records having the same target
+		var remainingCurrent []zoneRecord
+		for _, record := range g.current {
+			_, target := normalizedRecord(record, zone)
+			index := slices.IndexFunc(g.wanted, func(w zoneRecord) bool {
+				_, wantedTarget := normalizedRecord(w, zone)
+				return wantedTarget == target
+			})
+			if index < 0 {
+				remainingCurrent = append(remainingCurrent, record)
+				continue
+			}
+
+			if g.wanted[index].TTL != record.TTL {
+				updated := record
+				updated.TTL = g.wanted[index].TTL
+				updates = append(updates, updated)
+			}
+			g.wanted = slices.Delete(g.wanted, index, index+1)
+		}
+
+		// Update the remaining records in place when replacing the zone
+		for len(g.wanted) > 0 && len(remainingCurrent) > 0 && replace {
+			updated := remainingCurrent[0]
+			updated.Target = g.wanted[0].Target
+			updated.TTL = g.wanted[0].TTL
+			updates = append(updates, updated)
+
+			g.wanted = g.wanted[1:]
+			remainingCurrent = remainingCurrent[1:]
+		}
+
+		creates = append(creates, g.wanted...)
+		if replace {
+			deletes = append(deletes, remainingCurrent...)
+		}
+	}
+
+	return creates, updates, deletes, nil
+}
@@ -0,0 +1,200 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package domainzone
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/ovh/ovhcloud-cli/internal/display"
+	"github.com/ovh/ovhcloud-cli/internal/flags"
+	httpLib "github.com/ovh/ovhcloud-cli/internal/http"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// ZoneImportParams holds the parameters of the zone import command.
+	ZoneImportParams struct {
+		File    string
+		Replace bool
+		Merge   bool
+		DryRun  bool
+	}
+)
+
+// fetchZoneRecords returns the records of the given zone, sorted by name and type
+func fetchZoneRecords(zone string) ([]zoneRecord, error) {
+	path := fmt.Sprintf("/v1/domain/zone/%s/record", url.PathEscape(zone))
+
+	ids, err := httpLib.FetchArray(path, "")
+	if err != nil {
+		return nil, fmt.Errorf("error fetching records of %s: %w", zone, err)
+	}
+
+	records, err := httpLib.FetchObjectsParallel[zoneRecord](path+"/%s", ids, false)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching records of %s: %w", zone, err)
+	}
+
+	slices.SortStableFunc(records, func(a, b zoneRecord) int {
+		return strings.Compare(a.SubDomain+" "+a.FieldType+" "+a.Target, b.SubDomain+" "+b.FieldType+" "+b.Target)
+	})
+
+	return records, nil
+}
+
+func ExportZone(_ *cobra.Command, args []string) {
+	// Records are displayed as is in JSON and YAML
+	if flags.OutputFormatConfig.JsonOutput || flags.OutputFormatConfig.YamlOutput {
+		records, err := fetchZoneRecords(args[0])
+		if err != nil {
+			display.OutputError(&flags.OutputFormatConfig, "%s", err)
+			return
+		}
+
+		objects := make([]map[string]any, 0, len(records))
+		for _, record := range records {
+			objects = append(objects, map[string]any{
+				"subDomain": record.SubDomain,
+				"fieldType": record.FieldType,
+				"target":    record.Target,
+				"ttl":       record.TTL,
+			})
+		}
+
+		display.RenderTable(objects, recordColumnsToDisplay, &flags.OutputFormatConfig)
+		return
+	}
+
+	var content string
+	if err := httpLib.Client.Get(fmt.Sprintf("/v1/domain/zone/%s/export", url.PathEscape(args[0])), &content); err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "error exporting zone %s: %s", args[0], err)
+		return
+	}
+
+	display.OutputInfo(&flags.OutputFormatConfig, nil, "%s", content)
+}
+
+// formatZoneRecord returns a zone file representation of the given record
+func formatZoneRecord(record zoneRecord) string {
+	name := record.SubDomain
+	if name == "" {
+		name = "@"
+	}
+
+	return fmt.Sprintf("%s %d %s %s", name, record.TTL, record.FieldType, record.Target)
+}
+
+// formatZoneChanges returns the given changes as a readable diff, one record per line
+func formatZoneChanges(current, creates, updates, deletes []zoneRecord) string {
+	var output strings.Builder
+	for _, record := range creates {
+		fmt.Fprintf(&output, "+ %s\n", formatZoneRecord(record))
+	}
+	for _, record := range updates {
+		previous := current[slices.IndexFunc(current, func(r zoneRecord) bool { return r.ID == record.ID })]
+		fmt.Fprintf(&output, "~ %s -> %s\n", formatZoneRecord(previous), formatZoneRecord(record))
+	}
+	for _, record := range deletes {
+		fmt.Fprintf(&output, "- %s\n", formatZoneRecord(record))
+	}
+
+	return display.ColorizeDiff(output.String(), &flags.OutputFormatConfig)
+}
+
+func ImportZone(_ *cobra.Command, args []string) {
+	zone := args[0]
+
+	content, err := os.ReadFile(ZoneImportParams.File)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to read zone file: %s", err)
+		return
+	}
+
+	wanted, err := parseZoneFile(string(content), zone)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to parse zone file %s: %s", ZoneImportParams.File, err)
+		return
+	}
+
+	current, err := fetchZoneRecords(zone)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	// Name servers of the zone apex are managed by OVHcloud
+	current = slices.DeleteFunc(current, func(record zoneRecord) bool {
+		return record.FieldType == "NS" && record.SubDomain == ""
+	})
+
+	creates, updates, deletes, err := diffZoneRecords(current, wanted, zone, ZoneImportParams.Replace)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	output := formatZoneChanges(current, creates, updates, deletes)
+
+	details := map[string]any{
+		"created": creates,
+		"updated": updates,
+		"deleted": deletes,
+		"dryRun":  ZoneImportParams.DryRun,
+	}
+
+	switch {
+	case len(creates)+len(updates)+len(deletes) == 0:
+		display.OutputInfo(&flags.OutputFormatConfig, details, "✅ Zone %s is already up-to-date", zone)
+		return
+	case ZoneImportParams.DryRun:
+		display.OutputInfo(&flags.OutputFormatConfig, details, "%sDry run: %d record(s) would be created, %d updated and %d deleted",
+			output, len(creates), len(updates), len(deletes))
+		return
+	}
+
+	recordsPath := fmt.Sprintf("/v1/domain/zone/%s/record", url.PathEscape(zone))
+
+	// Records are deleted last so that a name never stops resolving. Records with the
+	// same name and type, such as a replaced CNAME, are updated in place by the diff.
+	for _, record := range updates {
+		if err := httpLib.Client.Put(fmt.Sprintf("%s/%d", recordsPath, record.ID), map[string]any{
+			"subDomain": record.SubDomain,
+			"target":    record.Target,
+			"ttl":       record.TTL,
+		}, nil); err != nil {
+			display.OutputError(&flags.OutputFormatConfig, "error updating record %s: %s", formatZoneRecord(record), err)
+			return
+		}
+	}
+	for _, record := range creates {
+		if err := httpLib.Client.Post(recordsPath, map[string]any{
+			"fieldType": record.FieldType,
+			"subDomain": record.SubDomain,
+			"target":    record.Target,
+			"ttl":       record.TTL,
+		}, nil); err != nil {
+			display.OutputError(&flags.OutputFormatConfig, "error creating record %s: %s", formatZoneRecord(record), err)
+			return
+		}
+	}
+	for _, record := range deletes {
+		if err := httpLib.Client.Delete(fmt.Sprintf("%s/%d", recordsPath, record.ID), nil); err != nil {
+			display.OutputError(&flags.OutputFormatConfig, "error deleting record %s: %s", formatZoneRecord(record), err)
+			return
+		}
+	}
+
+	if err := httpLib.Client.Post(fmt.Sprintf("/v1/domain/zone/%s/refresh", url.PathEscape(zone)), nil, nil); err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "records imported, but error refreshing zone %s: %s", zone, err)
+		return
+	}
+
+	display.OutputInfo(&flags.OutputFormatConfig, details, "%s✅ Zone %s imported: %d record(s) created, %d updated and %d deleted, zone refreshed",
+		output, zone, len(creates), len(updates), len(deletes))
+}
@@ -0,0 +1,286 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package domainzone
+
+import (
+	"fmt"
+	"maps"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/ovh/ovhcloud-cli/internal/display"
+	"github.com/ovh/ovhcloud-cli/internal/flags"
+	httpLib "github.com/ovh/ovhcloud-cli/internal/http"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// Prefix of the TXT records marking the records managed by the CLI
+	zoneOwnershipPrefix = "_ovhcloud-owner"
+
+	defaultZoneOwner = "ovhcloud-cli"
+)
+
+var (
+	// ZoneSyncParams holds the parameters of the zone sync command.
+	ZoneSyncParams struct {
+		File   string
+		DryRun bool
+		Adopt  bool
+	}
+)
+
+// zoneSyncFile is the content of the file given to the zone sync command
+type zoneSyncFile struct {
+	Owner   string       `json:"owner,omitempty"`
+	Records []zoneRecord `json:"records"`
+}
+
+// zoneRecordGroup identifies a set of records sharing the same name and type
+type zoneRecordGroup struct {
+	SubDomain string
+	FieldType string
+}
+
+// recordGroupOf returns the group of the given record
+func recordGroupOf(record zoneRecord, zone string) zoneRecordGroup {
+	key, _ := normalizedRecord(record, zone)
+	subDomain, fieldType, _ := strings.Cut(key, " ")
+
+	return zoneRecordGroup{SubDomain: subDomain, FieldType: fieldType}
+}
+
+// ownershipMarker returns the TXT record marking the given group as managed by owner
+func ownershipMarker(group zoneRecordGroup, owner string, ttl int) zoneRecord {
+	subDomain := zoneOwnershipPrefix + "." + strings.ToLower(group.FieldType)
+	if group.SubDomain != "" {
+		subDomain += "." + group.SubDomain
+	}
+
+	return zoneRecord{
+		SubDomain: subDomain,
+		FieldType: "TXT",
+		Target:    fmt.Sprintf(`"heritage=ovhcloud-cli,owner=%s"`, owner),
+		TTL:       ttl,
+	}
+}
+
+// parseOwnershipMarker returns the group and the owner described by the given record,
+// or false if the record is not an ownership marker
+func parseOwnershipMarker(record zoneRecord) (zoneRecordGroup, string, bool) {
+	name, ok := strings.CutPrefix(strings.ToLower(record.SubDomain), zoneOwnershipPrefix+".")
+	if !ok || record.FieldType != "TXT" {
+		return zoneRecordGroup{}, "", false
+	}
+
+	fieldType, subDomain, _ := strings.Cut(name, ".")
+	group := zoneRecordGroup{SubDomain: subDomain, FieldType: strings.ToUpper(fieldType)}
+
+	for _, attribute := range strings.Split(txtRecordValue(record.Target), ",") {
+		if owner, ok := strings.CutPrefix(attribute, "owner="); ok {
+			return group, owner, true
+		}
+	}
+
+	return group, "", true
+}
+
+// readZoneSyncFile reads and validates the records of the given file
+func readZoneSyncFile(path string) (*zoneSyncFile, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read records file: %w", err)
+	}
+
+	var file zoneSyncFile
+	if err := yaml.Unmarshal(content, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse records file %s: %w", path, err)
+	}
+
+	if file.Owner == "" {
+		file.Owner = defaultZoneOwner
+	}
+	if strings.ContainsAny(file.Owner, `,"`) {
+		return nil, fmt.Errorf("invalid owner %q, it cannot contain commas or quotes", file.Owner)
+	}
+
+	for i, record := range file.Records {
+		switch {
+		case record.FieldType == "":
+			return nil, fmt.Errorf("record %d has no fieldType", i+1)
+		case record.Target == "":
+			return nil, fmt.Errorf("record %d has no target", i+1)
+		case strings.HasPrefix(strings.ToLower(record.SubDomain), zoneOwnershipPrefix):
+			return nil, fmt.Errorf("record %d uses the reserved subDomain prefix %s", i+1, zoneOwnershipPrefix)
+		}
+		file.Records[i].FieldType = strings.ToUpper(record.FieldType)
+		file.Records[i].SubDomain = strings.TrimSuffix(record.SubDomain, ".")
+		if record.TTL == 0 {
+			file.Records[i].TTL = 3600
+		}
+	}
+
+	return &file, nil
+}
+
+func SyncZone(_ *cobra.Command, args []string) {
+	zone := args[0]
+
+	file, err := readZoneSyncFile(ZoneSyncParams.File)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	current, err := fetchZoneRecords(zone)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	// Split the current records between ownership markers and regular records
+	var (
+		markers       = make(map[zoneRecordGroup]zoneRecord)
+		owners        = make(map[zoneRecordGroup]string)
+		currentGroups = make(map[zoneRecordGroup][]zoneRecord)
+	)
+	for _, record := range current {
+		if group, owner, ok := parseOwnershipMarker(record); ok {
+			markers[group] = record
+			owners[group] = owner
+			continue
+		}
+
+		group := recordGroupOf(record, zone)
+		currentGroups[group] = append(currentGroups[group], record)
+	}
+
+	var (
+		wantedGroups = make(map[zoneRecordGroup][]zoneRecord)
+		groups       []zoneRecordGroup
+	)
+	for _, record := range file.Records {
+		group := recordGroupOf(record, zone)
+		if _, ok := wantedGroups[group]; !ok {
+			groups = append(groups, group)
+		}
+		wantedGroups[group] = append(wantedGroups[group], record)
+	}
+
+	var creates, updates, deletes []zoneRecord
+
+	for _, group := range groups {
+		owner, marked := owners[group]
+		switch {
+		case marked && owner != file.Owner:
+			display.OutputError(&flags.OutputFormatConfig, "records %s %s are managed by owner %q", formatZoneGroup(group), group.FieldType, owner)
+			return
+		case !marked && len(currentGroups[group]) > 0 && !ZoneSyncParams.Adopt:
+			display.OutputError(&flags.OutputFormatConfig, "records %s %s already exist and are not managed by owner %q, use --adopt to take them over",
+				formatZoneGroup(group), group.FieldType, file.Owner)
+			return
+		}
+
+		groupCreates, groupUpdates, groupDeletes, err := diffZoneRecords(currentGroups[group], wantedGroups[group], zone, true)
+		if err != nil {
+			display.OutputError(&flags.OutputFormatConfig, "%s", err)
+			return
+		}
+		creates = append(creates, groupCreates...)
+		updates = append(updates, groupUpdates...)
+		deletes = append(deletes, groupDeletes...)
+
+		if !marked {
+			creates = append(creates, ownershipMarker(group, file.Owner, wantedGroups[group][0].TTL))
+		}
+	}
+
+	// Records owned by us that are no longer defined in the file are deleted, along with their marker
+	managedGroups := slices.SortedFunc(maps.Keys(owners), func(a, b zoneRecordGroup) int {
+		return strings.Compare(a.SubDomain+" "+a.FieldType, b.SubDomain+" "+b.FieldType)
+	})
+	for _, group := range managedGroups {
+		if owners[group] != file.Owner {
+			continue
+		}
+		if _, ok := wantedGroups[group]; ok {
+			continue
+		}
+
+		deletes = append(deletes, currentGroups[group]...)
+		deletes = append(deletes, markers[group])
+	}
+
+	output := formatZoneChanges(current, creates, updates, deletes)
+
+	details := map[string]any{
+		"owner":   file.Owner,
+		"created": creates,
+		"updated": updates,
+		"deleted": deletes,
+		"dryRun":  ZoneSyncParams.DryRun,
+	}
+
+	switch {
+	case len(creates)+len(updates)+len(deletes) == 0:
+		display.OutputInfo(&flags.OutputFormatConfig, details, "✅ Zone %s is already in sync", zone)
+		return
+	case ZoneSyncParams.DryRun:
+		display.OutputInfo(&flags.OutputFormatConfig, details, "%sDry run: %d record(s) would be created, %d updated and %d deleted",
+			output, len(creates), len(updates), len(deletes))
+		return
+	}
+
+	recordsPath := fmt.Sprintf("/v1/domain/zone/%s/record", url.PathEscape(zone))
+
+	// Create records first so that a name never stops resolving, e.g. when a CNAME target is swapped
+	for _, record := range creates {
+		if err := httpLib.Client.Post(recordsPath, map[string]any{
+			"fieldType": record.FieldType,
+			"subDomain": record.SubDomain,
+			"target":    record.Target,
+			"ttl":       record.TTL,
+		}, nil); err != nil {
+			display.OutputError(&flags.OutputFormatConfig, "error creating record %s: %s", formatZoneRecord(record), err)
+			return
+		}
+	}
+	for _, record := range updates {
+		if err := httpLib.Client.Put(fmt.Sprintf("%s/%d", recordsPath, record.ID), map[string]any{
+			"subDomain": record.SubDomain,
+			"target":    record.Target,
+			"ttl":       record.TTL,
+		}, nil); err != nil {
+			display.OutputError(&flags.OutputFormatConfig, "error updating record %s: %s", formatZoneRecord(record), err)
+			return
+		}
+	}
+	for _, record := range deletes {
+		if err := httpLib.Client.Delete(fmt.Sprintf("%s/%d", recordsPath, record.ID), nil); err != nil {
+			display.OutputError(&flags.OutputFormatConfig, "error deleting record %s: %s", formatZoneRecord(record), err)
+			return
+		}
+	}
+
+	if err := httpLib.Client.Post(fmt.Sprintf("/v1/domain/zone/%s/refresh", url.PathEscape(zone)), nil, nil); err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "records synced, but error refreshing zone %s: %s", zone, err)
+		return
+	}
+
+	display.OutputInfo(&flags.OutputFormatConfig, details, "%s✅ Zone %s synced: %d record(s) created, %d updated and %d deleted, zone refreshed",
+		output, zone, len(creates), len(updates), len(deletes))
+}
+
+// formatZoneGroup returns the name of the given group, using @ for the zone apex
+func formatZoneGroup(group zoneRecordGroup) string {
+	if group.SubDomain == "" {
+		return "@"
+	}
+
+	return group.SubDomain
+}
@@ -84,7 +84,7 @@ var (
 	TokenSpec struct {
 		Name        string `json:"name,omitempty"`
 		Description string `json:"description,omitempty"`
-		ExpiredAt   string `json:"expiredAt,omitempty"`
+		ExpiredAt   string `json:"expiresAt,omitempty"`
 		ExpiresIn   int    `json:"expiresIn,omitempty"`
 	}
 )
@@ -178,10 +178,6 @@ func EditIAMPermissionsGroup(cmd *cobra.Command, args []string) {
 	}
 }
 
-func ListIAMResources(_ *cobra.Command, _ []string) {
-	common.ManageListRequestNoExpand("/v2/iam/resource", iamResourceColumnsToDisplay, flags.GenericFilters)
-}
-
 func GetIAMResource(_ *cobra.Command, args []string) {
 	common.ManageObjectRequest("/v2/iam/resource", args[0], iamResourceTemplate)
 }
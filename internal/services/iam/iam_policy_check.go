@@ -0,0 +1,363 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package iam
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/ovh/ovhcloud-cli/internal/display"
+	"github.com/ovh/ovhcloud-cli/internal/flags"
+	httpLib "github.com/ovh/ovhcloud-cli/internal/http"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// IAMPolicyCheckParams holds the request evaluated by the policy check command.
+	IAMPolicyCheckParams struct {
+		Identity string
+		Action   string
+		Resource string
+	}
+)
+
+type (
+	iamPermissions struct {
+		Allow  []iamPermission `json:"allow,omitempty"`
+		Deny   []iamPermission `json:"deny,omitempty"`
+		Except []iamPermission `json:"except,omitempty"`
+	}
+
+	iamPolicy struct {
+		ID                string           `json:"id"`
+		Name              string           `json:"name"`
+		Description       string           `json:"description,omitempty"`
+		ReadOnly          bool             `json:"readOnly"`
+		ExpiredAt         string           `json:"expiredAt,omitempty"`
+		Identities        []string         `json:"identities"`
+		Permissions       iamPermissions   `json:"permissions"`
+		PermissionsGroups []iamResourceURN `json:"permissionsGroups,omitempty"`
+		Resources         []iamResourceURN `json:"resources"`
+	}
+
+	// iamPolicyMatch describes how a policy applies to the evaluated request
+	iamPolicyMatch struct {
+		PolicyID   string `json:"policyId"`
+		PolicyName string `json:"policyName"`
+		Effect     string `json:"effect"`
+		Action     string `json:"action"`
+		Source     string `json:"source"`
+	}
+)
+
+// matchIAMPattern returns true if the given value matches the given pattern,
+// in which "*" matches any sequence of characters (including ":" and "/")
+func matchIAMPattern(pattern, value string) bool {
+	parts := strings.Split(pattern, "*")
+	if len(parts) == 1 {
+		return pattern == value
+	}
+
+	if !strings.HasPrefix(value, parts[0]) {
+		return false
+	}
+	value = value[len(parts[0]):]
+
+	for _, part := range parts[1 : len(parts)-1] {
+		index := strings.Index(value, part)
+		if index < 0 {
+			return false
+		}
+		value = value[index+len(part):]
+	}
+
+	return strings.HasSuffix(value, parts[len(parts)-1])
+}
+
+// matchingIAMAction returns the first of the given permissions matching the given action
+func matchingIAMAction(permissions []iamPermission, action string) (string, bool) {
+	for _, permission := range permissions {
+		if matchIAMPattern(permission.Action, action) {
+			return permission.Action, true
+		}
+	}
+
+	return "", false
+}
+
+// fetchIAMList returns all the objects of the given IAM list endpoint
+func fetchIAMList[T any](path string) ([]T, error) {
+	body, err := httpLib.FetchArray(path, "")
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert the generic objects to the expected type
+	bytes, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	var objects []T
+	if err := json.Unmarshal(bytes, &objects); err != nil {
+		return nil, err
+	}
+
+	return objects, nil
+}
+
+// fetchIAMPolicies returns all the IAM policies of the account
+func fetchIAMPolicies() ([]iamPolicy, error) {
+	policies, err := fetchIAMList[iamPolicy]("/v2/iam/policy")
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch IAM policies: %w", err)
+	}
+
+	return policies, nil
+}
+
+// iamPolicyEvaluator evaluates IAM policies for a given request, caching the
+// permissions groups and resource groups fetched from the API
+type iamPolicyEvaluator struct {
+	identities        []string
+	action            string
+	resource          string
+	permissionsGroups map[string]iamPermissionsGroup
+	resourceGroups    map[string][]string
+}
+
+type iamPermissionsGroup struct {
+	URN         string         `json:"urn"`
+	Name        string         `json:"name"`
+	Description string         `json:"description,omitempty"`
+	Owner       string         `json:"owner"`
+	ReadOnly    bool           `json:"readOnly"`
+	Permissions iamPermissions `json:"permissions"`
+}
+
+// resolveIAMIdentities returns the given identity along with the identity of
+// its group, when it is a local user
+func resolveIAMIdentities(identity string) ([]string, error) {
+	identities := []string{identity}
+
+	// Local users are identified by urn:v1:<region>:identity:user:<account>/<login>
+	prefix, login, ok := strings.Cut(identity, "/")
+	if !ok || !strings.Contains(prefix, ":identity:user:") {
+		return identities, nil
+	}
+
+	var user struct {
+		Group string `json:"group"`
+	}
+	if err := httpLib.Client.Get(fmt.Sprintf("/v1/me/identity/user/%s", url.PathEscape(login)), &user); err != nil {
+		return nil, fmt.Errorf("failed to fetch user %s: %w", login, err)
+	}
+
+	if user.Group != "" {
+		groupPrefix := strings.Replace(prefix, ":identity:user:", ":identity:group:", 1)
+		identities = append(identities, groupPrefix+"/"+user.Group)
+	}
+
+	return identities, nil
+}
+
+// getPermissionsGroup returns the given permissions group
+func (e *iamPolicyEvaluator) getPermissionsGroup(urn string) (iamPermissionsGroup, error) {
+	if group, ok := e.permissionsGroups[urn]; ok {
+		return group, nil
+	}
+
+	var group iamPermissionsGroup
+	if err := httpLib.Client.Get(fmt.Sprintf("/v2/iam/permissionsGroup/%s", url.PathEscape(urn)), &group); err != nil {
+		return group, fmt.Errorf("failed to fetch permissions group %s: %w", urn, err)
+	}
+	e.permissionsGroups[urn] = group
+
+	return group, nil
+}
+
+// getResourceGroupURNs returns the URNs of the resources of the given resource group
+func (e *iamPolicyEvaluator) getResourceGroupURNs(urn string) ([]string, error) {
+	if urns, ok := e.resourceGroups[urn]; ok {
+		return urns, nil
+	}
+
+	// Resource groups are identified by urn:v1:<region>:resourceGroup:<id>
+	id := urn[strings.LastIndex(urn, ":")+1:]
+
+	var group struct {
+		Resources []iamResourceURN `json:"resources"`
+	}
+	if err := httpLib.Client.Get(fmt.Sprintf("/v2/iam/resourceGroup/%s?details=true", url.PathEscape(id)), &group); err != nil {
+		return nil, fmt.Errorf("failed to fetch resource group %s: %w", id, err)
+	}
+
+	urns := make([]string, 0, len(group.Resources))
+	for _, resource := range group.Resources {
+		urns = append(urns, resource.URN)
+	}
+	e.resourceGroups[urn] = urns
+
+	return urns, nil
+}
+
+// appliesTo returns true if the given policy applies to the identity and resource of the request
+func (e *iamPolicyEvaluator) appliesTo(policy iamPolicy) (bool, error) {
+	if policy.ExpiredAt != "" {
+		if expiredAt, err := time.Parse(time.RFC3339, policy.ExpiredAt); err == nil && expiredAt.Before(time.Now()) {
+			return false, nil
+		}
+	}
+
+	if !slices.ContainsFunc(policy.Identities, func(pattern string) bool {
+		return slices.ContainsFunc(e.identities, func(identity string) bool { return matchIAMPattern(pattern, identity) })
+	}) {
+		return false, nil
+	}
+
+	for _, resource := range policy.Resources {
+		if strings.Contains(resource.URN, ":resourceGroup:") {
+			urns, err := e.getResourceGroupURNs(resource.URN)
+			if err != nil {
+				return false, err
+			}
+			if slices.ContainsFunc(urns, func(urn string) bool { return matchIAMPattern(urn, e.resource) }) {
+				return true, nil
+			}
+		} else if matchIAMPattern(resource.URN, e.resource) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// evaluate returns how the given policy applies to the action of the request.
+// A policy denies the action if one of its denied actions matches it, and allows it
+// if one of its allowed actions matches it and none of its excepted actions do.
+func (e *iamPolicyEvaluator) evaluate(policy iamPolicy) ([]iamPolicyMatch, error) {
+	type permissionsSource struct {
+		name        string
+		permissions iamPermissions
+	}
+
+	sources := []permissionsSource{{name: "policy", permissions: policy.Permissions}}
+	for _, group := range policy.PermissionsGroups {
+		permissionsGroup, err := e.getPermissionsGroup(group.URN)
+		if err != nil {
+			return nil, err
+		}
+		sources = append(sources, permissionsSource{
+			name:        fmt.Sprintf("permissions group %s", group.URN),
+			permissions: permissionsGroup.Permissions,
+		})
+	}
+
+	// Excepted actions apply to all the allowed actions of the policy
+	var excepted []iamPermission
+	for _, source := range sources {
+		excepted = append(excepted, source.permissions.Except...)
+	}
+
+	var matches []iamPolicyMatch
+	for _, source := range sources {
+		if action, ok := matchingIAMAction(source.permissions.Deny, e.action); ok {
+			matches = append(matches, iamPolicyMatch{PolicyID: policy.ID, PolicyName: policy.Name, Effect: "deny", Action: action, Source: source.name})
+		}
+		if action, ok := matchingIAMAction(source.permissions.Allow, e.action); ok {
+			if exceptAction, excluded := matchingIAMAction(excepted, e.action); excluded {
+				matches = append(matches, iamPolicyMatch{PolicyID: policy.ID, PolicyName: policy.Name, Effect: "except", Action: exceptAction, Source: source.name})
+			} else {
+				matches = append(matches, iamPolicyMatch{PolicyID: policy.ID, PolicyName: policy.Name, Effect: "allow", Action: action, Source: source.name})
+			}
+		}
+	}
+
+	return matches, nil
+}
+
+func CheckIAMPolicies(_ *cobra.Command, _ []string) {
+	identities, err := resolveIAMIdentities(IAMPolicyCheckParams.Identity)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	policies, err := fetchIAMPolicies()
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	evaluator := &iamPolicyEvaluator{
+		identities:        identities,
+		action:            IAMPolicyCheckParams.Action,
+		resource:          IAMPolicyCheckParams.Resource,
+		permissionsGroups: make(map[string]iamPermissionsGroup),
+		resourceGroups:    make(map[string][]string),
+	}
+
+	var matches []iamPolicyMatch
+	for _, policy := range policies {
+		applies, err := evaluator.appliesTo(policy)
+		if err != nil {
+			display.OutputError(&flags.OutputFormatConfig, "%s", err)
+			return
+		}
+		if !applies {
+			continue
+		}
+
+		policyMatches, err := evaluator.evaluate(policy)
+		if err != nil {
+			display.OutputError(&flags.OutputFormatConfig, "%s", err)
+			return
+		}
+		matches = append(matches, policyMatches...)
+	}
+
+	// Denied actions take precedence over allowed ones
+	decision := "implicitDeny"
+	switch {
+	case slices.ContainsFunc(matches, func(m iamPolicyMatch) bool { return m.Effect == "deny" }):
+		decision = "deny"
+	case slices.ContainsFunc(matches, func(m iamPolicyMatch) bool { return m.Effect == "allow" }):
+		decision = "allow"
+	}
+
+	var message strings.Builder
+	if decision == "allow" {
+		fmt.Fprintf(&message, "✅ %s is allowed to perform %s on %s", IAMPolicyCheckParams.Identity, IAMPolicyCheckParams.Action, IAMPolicyCheckParams.Resource)
+	} else {
+		fmt.Fprintf(&message, "❌ %s is not allowed to perform %s on %s", IAMPolicyCheckParams.Identity, IAMPolicyCheckParams.Action, IAMPolicyCheckParams.Resource)
+	}
+	if len(matches) == 0 {
+		message.WriteString("\n  - no policy allows this action")
+	}
+	for _, match := range matches {
+		var verb string
+		switch match.Effect {
+		case "allow":
+			verb = "allowed"
+		case "deny":
+			verb = "denied"
+		case "except":
+			verb = "excluded"
+		}
+		fmt.Fprintf(&message, "\n  - %s by policy %q (%s) through action %q of %s", verb, match.PolicyName, match.PolicyID, match.Action, match.Source)
+	}
+
+	display.OutputInfo(&flags.OutputFormatConfig, map[string]any{
+		"identities": identities,
+		"action":     IAMPolicyCheckParams.Action,
+		"resource":   IAMPolicyCheckParams.Resource,
+		"decision":   decision,
+		"matches":    matches,
+	}, "%s", message.String())
+}
@@ -0,0 +1,818 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package iam
+
+import (
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/ovh/ovhcloud-cli/internal/display"
+	"github.com/ovh/ovhcloud-cli/internal/flags"
+	httpLib "github.com/ovh/ovhcloud-cli/internal/http"
+	"github.com/spf13/cobra"
+)
+
+const (
+	iamPoliciesDir          = "policies"
+	iamPermissionsGroupsDir = "permissions-groups"
+	iamResourceGroupsDir    = "resource-groups"
+)
+
+var (
+	// IAMPolicyCodeParams holds the parameters of the policy export and import commands.
+	IAMPolicyCodeParams struct {
+		Dir    string
+		Prune  bool
+		DryRun bool
+	}
+
+	iamFileNameReplacer = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+)
+
+type (
+	// iamResourceReference is a resource of a policy or resource group, as exported in YAML.
+	// The display name is informative and ignored on import.
+	iamResourceReference struct {
+		URN           string `json:"urn,omitempty"`
+		DisplayName   string `json:"displayName,omitempty"`
+		ResourceGroup string `json:"resourceGroup,omitempty"`
+	}
+
+	// iamPolicyDefinition is a policy as exported and imported in YAML,
+	// referencing permissions groups and resource groups by name
+	iamPolicyDefinition struct {
+		Name              string                 `json:"name"`
+		Description       string                 `json:"description,omitempty"`
+		ExpiredAt         string                 `json:"expiredAt,omitempty"`
+		Identities        []string               `json:"identities"`
+		Resources         []iamResourceReference `json:"resources"`
+		PermissionsGroups []string               `json:"permissionsGroups,omitempty"`
+		Allow             []string               `json:"allow,omitempty"`
+		Except            []string               `json:"except,omitempty"`
+		Deny              []string               `json:"deny,omitempty"`
+	}
+
+	// iamPermissionsGroupDefinition is a permissions group as exported and imported in YAML
+	iamPermissionsGroupDefinition struct {
+		Name        string   `json:"name"`
+		Description string   `json:"description,omitempty"`
+		Allow       []string `json:"allow,omitempty"`
+		Except      []string `json:"except,omitempty"`
+		Deny        []string `json:"deny,omitempty"`
+	}
+
+	// iamResourceGroupDefinition is a resource group as exported and imported in YAML
+	iamResourceGroupDefinition struct {
+		Name      string                 `json:"name"`
+		Resources []iamResourceReference `json:"resources"`
+	}
+
+	iamResourceGroup struct {
+		ID        string `json:"id"`
+		URN       string `json:"urn"`
+		Name      string `json:"name"`
+		Owner     string `json:"owner"`
+		ReadOnly  bool   `json:"readOnly"`
+		Resources []struct {
+			URN         string `json:"urn"`
+			DisplayName string `json:"displayName"`
+		} `json:"resources"`
+	}
+
+	// iamCodeState holds the IAM objects of the account, and the lookup tables used
+	// to convert them to and from their YAML definitions
+	iamCodeState struct {
+		policies          []iamPolicy
+		permissionsGroups []iamPermissionsGroup
+		resourceGroups    []iamResourceGroup
+
+		displayNames          map[string]string
+		permissionsGroupNames map[string]string
+		permissionsGroupURNs  map[string]string
+		resourceGroupNames    map[string]string
+		resourceGroupURNs     map[string]string
+	}
+
+	// iamChange is a change to apply to the account during an import
+	iamChange struct {
+		Action string   `json:"action"`
+		Kind   string   `json:"kind"`
+		Name   string   `json:"name"`
+		Diff   []string `json:"diff,omitempty"`
+
+		apply func() error
+	}
+)
+
+// isManagedIAMObject returns true for the objects managed by OVHcloud, that cannot be edited
+func isManagedIAMObject(owner string, readOnly bool) bool {
+	return readOnly || owner == "ovh"
+}
+
+func permissionsToActions(permissions []iamPermission) []string {
+	actions := make([]string, 0, len(permissions))
+	for _, permission := range permissions {
+		actions = append(actions, permission.Action)
+	}
+	slices.Sort(actions)
+
+	return actions
+}
+
+func actionsToPermissions(actions []string) []iamPermission {
+	permissions := make([]iamPermission, 0, len(actions))
+	for _, action := range actions {
+		permissions = append(permissions, iamPermission{Action: action})
+	}
+
+	return permissions
+}
+
+func sortIAMResourceReferences(references []iamResourceReference) {
+	slices.SortFunc(references, func(a, b iamResourceReference) int {
+		return cmp.Or(cmp.Compare(a.ResourceGroup, b.ResourceGroup), cmp.Compare(a.URN, b.URN))
+	})
+}
+
+// withoutDisplayNames returns a copy of the given references without their
+// informative display names, to compare them
+func withoutDisplayNames(references []iamResourceReference) []iamResourceReference {
+	stripped := make([]iamResourceReference, 0, len(references))
+	for _, reference := range references {
+		reference.DisplayName = ""
+		stripped = append(stripped, reference)
+	}
+	sortIAMResourceReferences(stripped)
+
+	return stripped
+}
+
+// fetchIAMCodeState fetches the IAM objects of the account
+func fetchIAMCodeState(withDisplayNames bool) (*iamCodeState, error) {
+	var (
+		state = &iamCodeState{
+			displayNames:          make(map[string]string),
+			permissionsGroupNames: make(map[string]string),
+			permissionsGroupURNs:  make(map[string]string),
+			resourceGroupNames:    make(map[string]string),
+			resourceGroupURNs:     make(map[string]string),
+		}
+		err error
+	)
+
+	if state.policies, err = fetchIAMPolicies(); err != nil {
+		return nil, err
+	}
+	if state.permissionsGroups, err = fetchIAMList[iamPermissionsGroup]("/v2/iam/permissionsGroup"); err != nil {
+		return nil, fmt.Errorf("failed to fetch IAM permissions groups: %w", err)
+	}
+	if state.resourceGroups, err = fetchIAMList[iamResourceGroup]("/v2/iam/resourceGroup?details=true"); err != nil {
+		return nil, fmt.Errorf("failed to fetch IAM resource groups: %w", err)
+	}
+
+	if withDisplayNames {
+		resources, err := fetchIAMList[iamResourceReference]("/v2/iam/resource")
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch IAM resources: %w", err)
+		}
+		for _, resource := range resources {
+			state.displayNames[resource.URN] = resource.DisplayName
+		}
+	}
+
+	for _, group := range state.permissionsGroups {
+		if !isManagedIAMObject(group.Owner, group.ReadOnly) {
+			state.permissionsGroupNames[group.URN] = group.Name
+			state.permissionsGroupURNs[group.Name] = group.URN
+		}
+	}
+	for _, group := range state.resourceGroups {
+		if !isManagedIAMObject(group.Owner, group.ReadOnly) {
+			state.resourceGroupNames[group.URN] = group.Name
+			state.resourceGroupURNs[group.Name] = group.URN
+		}
+	}
+
+	return state, nil
+}
+
+func (s *iamCodeState) policyDefinition(policy iamPolicy) iamPolicyDefinition {
+	definition := iamPolicyDefinition{
+		Name:        policy.Name,
+		Description: policy.Description,
+		ExpiredAt:   policy.ExpiredAt,
+		Identities:  slices.Sorted(slices.Values(policy.Identities)),
+		Resources:   make([]iamResourceReference, 0, len(policy.Resources)),
+		Allow:       permissionsToActions(policy.Permissions.Allow),
+		Except:      permissionsToActions(policy.Permissions.Except),
+		Deny:        permissionsToActions(policy.Permissions.Deny),
+	}
+
+	for _, resource := range policy.Resources {
+		if name, ok := s.resourceGroupNames[resource.URN]; ok {
+			definition.Resources = append(definition.Resources, iamResourceReference{ResourceGroup: name})
+		} else {
+			definition.Resources = append(definition.Resources, iamResourceReference{URN: resource.URN, DisplayName: s.displayNames[resource.URN]})
+		}
+	}
+	sortIAMResourceReferences(definition.Resources)
+
+	for _, group := range policy.PermissionsGroups {
+		if name, ok := s.permissionsGroupNames[group.URN]; ok {
+			definition.PermissionsGroups = append(definition.PermissionsGroups, name)
+		} else {
+			definition.PermissionsGroups = append(definition.PermissionsGroups, group.URN)
+		}
+	}
+	slices.Sort(definition.PermissionsGroups)
+
+	return definition
+}
+
+func (s *iamCodeState) permissionsGroupDefinition(group iamPermissionsGroup) iamPermissionsGroupDefinition {
+	return iamPermissionsGroupDefinition{
+		Name:        group.Name,
+		Description: group.Description,
+		Allow:       permissionsToActions(group.Permissions.Allow),
+		Except:      permissionsToActions(group.Permissions.Except),
+		Deny:        permissionsToActions(group.Permissions.Deny),
+	}
+}
+
+func (s *iamCodeState) resourceGroupDefinition(group iamResourceGroup) iamResourceGroupDefinition {
+	definition := iamResourceGroupDefinition{
+		Name:      group.Name,
+		Resources: make([]iamResourceReference, 0, len(group.Resources)),
+	}
+	for _, resource := range group.Resources {
+		displayName := cmp.Or(resource.DisplayName, s.displayNames[resource.URN])
+		definition.Resources = append(definition.Resources, iamResourceReference{URN: resource.URN, DisplayName: displayName})
+	}
+	sortIAMResourceReferences(definition.Resources)
+
+	return definition
+}
+
+// resolveResources returns the URNs of the given resources, resolving resource groups from their name
+func (s *iamCodeState) resolveResources(references []iamResourceReference) ([]iamResourceURN, error) {
+	urns := make([]iamResourceURN, 0, len(references))
+	for _, reference := range references {
+		switch {
+		case reference.ResourceGroup != "":
+			urn, ok := s.resourceGroupURNs[reference.ResourceGroup]
+			if !ok {
+				return nil, fmt.Errorf("unknown resource group %q", reference.ResourceGroup)
+			}
+			urns = append(urns, iamResourceURN{URN: urn})
+		case reference.URN != "":
+			urns = append(urns, iamResourceURN{URN: reference.URN})
+		default:
+			return nil, errors.New("resources must define either an urn or a resourceGroup")
+		}
+	}
+
+	return urns, nil
+}
+
+// policyBody returns the API body of the given policy definition
+func (s *iamCodeState) policyBody(definition iamPolicyDefinition) (map[string]any, error) {
+	resources, err := s.resolveResources(definition.Resources)
+	if err != nil {
+		return nil, err
+	}
+
+	permissionsGroups := make([]iamResourceURN, 0, len(definition.PermissionsGroups))
+	for _, group := range definition.PermissionsGroups {
+		urn := group
+		if !strings.HasPrefix(group, "urn:") {
+			var ok bool
+			if urn, ok = s.permissionsGroupURNs[group]; !ok {
+				return nil, fmt.Errorf("unknown permissions group %q", group)
+			}
+		}
+		permissionsGroups = append(permissionsGroups, iamResourceURN{URN: urn})
+	}
+
+	body := map[string]any{
+		"name":        definition.Name,
+		"description": definition.Description,
+		"identities":  definition.Identities,
+		"resources":   resources,
+		"permissions": iamPermissions{
+			Allow:  actionsToPermissions(definition.Allow),
+			Except: actionsToPermissions(definition.Except),
+			Deny:   actionsToPermissions(definition.Deny),
+		},
+		"permissionsGroups": permissionsGroups,
+	}
+	if definition.ExpiredAt != "" {
+		body["expiredAt"] = definition.ExpiredAt
+	}
+
+	return body, nil
+}
+
+// iamDefinitionFileName returns a file name derived from the given object name
+func iamDefinitionFileName(name string, used map[string]bool) string {
+	base := strings.Trim(iamFileNameReplacer.ReplaceAllString(name, "_"), "_")
+	if base == "" {
+		base = "unnamed"
+	}
+
+	fileName := base + ".yaml"
+	for i := 2; used[fileName]; i++ {
+		fileName = fmt.Sprintf("%s-%d.yaml", base, i)
+	}
+	used[fileName] = true
+
+	return fileName
+}
+
+// writeIAMDefinitions writes each of the given definitions in its own YAML file of the given directory
+func writeIAMDefinitions[T any](dir string, definitions []T, name func(T) string) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	// Remove the files of a previous export, so that deleted objects are not recreated by the next import
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if entry.IsDir() || (filepath.Ext(entry.Name()) != ".yaml" && filepath.Ext(entry.Name()) != ".yml") {
+			continue
+		}
+		if err := os.Remove(filepath.Join(dir, entry.Name())); err != nil {
+			return err
+		}
+	}
+
+	used := make(map[string]bool)
+	for _, definition := range definitions {
+		content, err := yaml.Marshal(definition)
+		if err != nil {
+			return err
+		}
+
+		if err := os.WriteFile(filepath.Join(dir, iamDefinitionFileName(name(definition), used)), content, 0o644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// readIAMDefinitions reads the definitions of the YAML files of the given directory
+func readIAMDefinitions[T any](dir string, name func(T) string) ([]T, error) {
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var (
+		definitions []T
+		names       = make(map[string]string)
+	)
+	for _, entry := range entries {
+		if entry.IsDir() || (filepath.Ext(entry.Name()) != ".yaml" && filepath.Ext(entry.Name()) != ".yml") {
+			continue
+		}
+
+		path := filepath.Join(dir, entry.Name())
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		var definition T
+		if err := yaml.Unmarshal(content, &definition); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+		}
+
+		switch previous, ok := names[name(definition)]; {
+		case name(definition) == "":
+			return nil, fmt.Errorf("name is missing in %s", path)
+		case ok:
+			return nil, fmt.Errorf("name %q is defined in both %s and %s", name(definition), previous, path)
+		}
+		names[name(definition)] = path
+
+		definitions = append(definitions, definition)
+	}
+
+	return definitions, nil
+}
+
+// iamDefinitionDiff returns the fields that differ between the two given definitions
+func iamDefinitionDiff(current, wanted any) []string {
+	toMap := func(value any) map[string]any {
+		var result map[string]any
+		bytes, _ := json.Marshal(value)
+		_ = json.Unmarshal(bytes, &result)
+		return result
+	}
+	currentFields, wantedFields := toMap(current), toMap(wanted)
+
+	keys := slices.Sorted(func(yield func(string) bool) {
+		for key := range currentFields {
+			if !yield(key) {
+				return
+			}
+		}
+		for key := range wantedFields {
+			if _, ok := currentFields[key]; !ok && !yield(key) {
+				return
+			}
+		}
+	})
+
+	format := func(value any) string {
+		if value == nil {
+			return "(none)"
+		}
+		bytes, _ := json.Marshal(value)
+		return string(bytes)
+	}
+
+	var diff []string
+	for _, key := range keys {
+		if !reflect.DeepEqual(currentFields[key], wantedFields[key]) {
+			diff = append(diff, fmt.Sprintf("%s: %s -> %s", key, format(currentFields[key]), format(wantedFields[key])))
+		}
+	}
+
+	return diff
+}
+
+func ExportIAMPolicies(_ *cobra.Command, _ []string) {
+	state, err := fetchIAMCodeState(true)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	var (
+		policies          []iamPolicyDefinition
+		permissionsGroups []iamPermissionsGroupDefinition
+		resourceGroups    []iamResourceGroupDefinition
+	)
+	for _, policy := range state.policies {
+		if !policy.ReadOnly {
+			policies = append(policies, state.policyDefinition(policy))
+		}
+	}
+	for _, group := range state.permissionsGroups {
+		if !isManagedIAMObject(group.Owner, group.ReadOnly) {
+			permissionsGroups = append(permissionsGroups, state.permissionsGroupDefinition(group))
+		}
+	}
+	for _, group := range state.resourceGroups {
+		if !isManagedIAMObject(group.Owner, group.ReadOnly) {
+			resourceGroups = append(resourceGroups, state.resourceGroupDefinition(group))
+		}
+	}
+
+	if err := writeIAMDefinitions(filepath.Join(IAMPolicyCodeParams.Dir, iamPoliciesDir), policies,
+		func(d iamPolicyDefinition) string { return d.Name }); err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to write policies: %s", err)
+		return
+	}
+	if err := writeIAMDefinitions(filepath.Join(IAMPolicyCodeParams.Dir, iamPermissionsGroupsDir), permissionsGroups,
+		func(d iamPermissionsGroupDefinition) string { return d.Name }); err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to write permissions groups: %s", err)
+		return
+	}
+	if err := writeIAMDefinitions(filepath.Join(IAMPolicyCodeParams.Dir, iamResourceGroupsDir), resourceGroups,
+		func(d iamResourceGroupDefinition) string { return d.Name }); err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to write resource groups: %s", err)
+		return
+	}
+
+	display.OutputInfo(&flags.OutputFormatConfig, map[string]any{
+		"policies":          len(policies),
+		"permissionsGroups": len(permissionsGroups),
+		"resourceGroups":    len(resourceGroups),
+	}, "✅ Exported %d policies, %d permissions groups and %d resource groups to %s",
+		len(policies), len(permissionsGroups), len(resourceGroups), IAMPolicyCodeParams.Dir)
+}
+
+// sortIAMChanges sorts the given changes by name, as they are built from maps
+func sortIAMChanges(changes []iamChange) {
+	slices.SortFunc(changes, func(a, b iamChange) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+}
+
+// planIAMImport computes the changes needed for the account to match the given definitions.
+// The returned count is the number of objects not defined in the files that are kept.
+func planIAMImport(
+	state *iamCodeState,
+	policies []iamPolicyDefinition,
+	permissionsGroups []iamPermissionsGroupDefinition,
+	resourceGroups []iamResourceGroupDefinition,
+) ([]iamChange, int, error) {
+	var (
+		changes []iamChange
+		deletes []iamChange
+		kept    int
+	)
+
+	// Resource groups
+	existingResourceGroups := make(map[string]iamResourceGroup)
+	for _, group := range state.resourceGroups {
+		if isManagedIAMObject(group.Owner, group.ReadOnly) {
+			if slices.ContainsFunc(resourceGroups, func(d iamResourceGroupDefinition) bool { return d.Name == group.Name }) {
+				return nil, 0, fmt.Errorf("resource group %q is managed by OVHcloud and cannot be imported", group.Name)
+			}
+			continue
+		}
+		existingResourceGroups[group.Name] = group
+	}
+	for _, definition := range resourceGroups {
+		urns, err := state.resolveResources(definition.Resources)
+		if err != nil {
+			return nil, 0, fmt.Errorf("invalid resource group %q: %w", definition.Name, err)
+		}
+		body := map[string]any{"name": definition.Name, "resources": urns}
+
+		existing, ok := existingResourceGroups[definition.Name]
+		if !ok {
+			changes = append(changes, iamChange{Action: "create", Kind: "resource group", Name: definition.Name, apply: func() error {
+				var created iamResourceGroup
+				if err := httpLib.Client.Post("/v2/iam/resourceGroup", body, &created); err != nil {
+					return err
+				}
+				state.resourceGroupURNs[definition.Name] = created.URN
+				return nil
+			}})
+			// Allow policies to reference the group before its creation
+			state.resourceGroupURNs[definition.Name] = "urn:v1:eu:resourceGroup:" + definition.Name
+			continue
+		}
+
+		current := state.resourceGroupDefinition(existing)
+		current.Resources = withoutDisplayNames(current.Resources)
+		definition.Resources = withoutDisplayNames(definition.Resources)
+		if diff := iamDefinitionDiff(current, definition); len(diff) > 0 {
+			changes = append(changes, iamChange{Action: "update", Kind: "resource group", Name: definition.Name, Diff: diff, apply: func() error {
+				return httpLib.Client.Put(fmt.Sprintf("/v2/iam/resourceGroup/%s", url.PathEscape(existing.ID)), body, nil)
+			}})
+		}
+	}
+	for name, existing := range existingResourceGroups {
+		if slices.ContainsFunc(resourceGroups, func(d iamResourceGroupDefinition) bool { return d.Name == name }) {
+			continue
+		}
+		if !IAMPolicyCodeParams.Prune {
+			kept++
+			continue
+		}
+		deletes = append(deletes, iamChange{Action: "delete", Kind: "resource group", Name: name, apply: func() error {
+			return httpLib.Client.Delete(fmt.Sprintf("/v2/iam/resourceGroup/%s", url.PathEscape(existing.ID)), nil)
+		}})
+	}
+
+	// Permissions groups
+	existingPermissionsGroups := make(map[string]iamPermissionsGroup)
+	for _, group := range state.permissionsGroups {
+		if isManagedIAMObject(group.Owner, group.ReadOnly) {
+			if slices.ContainsFunc(permissionsGroups, func(d iamPermissionsGroupDefinition) bool { return d.Name == group.Name }) {
+				return nil, 0, fmt.Errorf("permissions group %q is managed by OVHcloud and cannot be imported", group.Name)
+			}
+			continue
+		}
+		existingPermissionsGroups[group.Name] = group
+	}
+	for _, definition := range permissionsGroups {
+		body := map[string]any{
+			"name":        definition.Name,
+			"description": definition.Description,
+			"permissions": iamPermissions{
+				Allow:  actionsToPermissions(definition.Allow),
+				Except: actionsToPermissions(definition.Except),
+				Deny:   actionsToPermissions(definition.Deny),
+			},
+		}
+
+		existing, ok := existingPermissionsGroups[definition.Name]
+		if !ok {
+			changes = append(changes, iamChange{Action: "create", Kind: "permissions group", Name: definition.Name, apply: func() error {
+				var created iamPermissionsGroup
+				if err := httpLib.Client.Post("/v2/iam/permissionsGroup", body, &created); err != nil {
+					return err
+				}
+				state.permissionsGroupURNs[definition.Name] = created.URN
+				return nil
+			}})
+			state.permissionsGroupURNs[definition.Name] = "urn:v2:eu:permissionsGroup:" + definition.Name
+			continue
+		}
+
+		slices.Sort(definition.Allow)
+		slices.Sort(definition.Except)
+		slices.Sort(definition.Deny)
+		if diff := iamDefinitionDiff(state.permissionsGroupDefinition(existing), definition); len(diff) > 0 {
+			changes = append(changes, iamChange{Action: "update", Kind: "permissions group", Name: definition.Name, Diff: diff, apply: func() error {
+				return httpLib.Client.Put(fmt.Sprintf("/v2/iam/permissionsGroup/%s", url.PathEscape(existing.URN)), body, nil)
+			}})
+		}
+	}
+	sortIAMChanges(deletes)
+
+	var groupDeletes []iamChange
+	for name, existing := range existingPermissionsGroups {
+		if slices.ContainsFunc(permissionsGroups, func(d iamPermissionsGroupDefinition) bool { return d.Name == name }) {
+			continue
+		}
+		if !IAMPolicyCodeParams.Prune {
+			kept++
+			continue
+		}
+		groupDeletes = append(groupDeletes, iamChange{Action: "delete", Kind: "permissions group", Name: name, apply: func() error {
+			return httpLib.Client.Delete(fmt.Sprintf("/v2/iam/permissionsGroup/%s", url.PathEscape(existing.URN)), nil)
+		}})
+	}
+	sortIAMChanges(groupDeletes)
+	deletes = append(groupDeletes, deletes...)
+
+	// Policies
+	existingPolicies := make(map[string]iamPolicy)
+	for _, policy := range state.policies {
+		if policy.ReadOnly {
+			if slices.ContainsFunc(policies, func(d iamPolicyDefinition) bool { return d.Name == policy.Name }) {
+				return nil, 0, fmt.Errorf("policy %q is managed by OVHcloud and cannot be imported", policy.Name)
+			}
+			continue
+		}
+		if _, ok := existingPolicies[policy.Name]; ok {
+			return nil, 0, fmt.Errorf("several policies are named %q", policy.Name)
+		}
+		existingPolicies[policy.Name] = policy
+	}
+	for _, definition := range policies {
+		// Check that references can be resolved
+		if _, err := state.policyBody(definition); err != nil {
+			return nil, 0, fmt.Errorf("invalid policy %q: %w", definition.Name, err)
+		}
+
+		existing, ok := existingPolicies[definition.Name]
+		if !ok {
+			changes = append(changes, iamChange{Action: "create", Kind: "policy", Name: definition.Name, apply: func() error {
+				body, err := state.policyBody(definition)
+				if err != nil {
+					return err
+				}
+				return httpLib.Client.Post("/v2/iam/policy", body, nil)
+			}})
+			continue
+		}
+
+		current := state.policyDefinition(existing)
+		current.Resources = withoutDisplayNames(current.Resources)
+		definition.Resources = withoutDisplayNames(definition.Resources)
+		slices.Sort(definition.Identities)
+		slices.Sort(definition.PermissionsGroups)
+		slices.Sort(definition.Allow)
+		slices.Sort(definition.Except)
+		slices.Sort(definition.Deny)
+		if diff := iamDefinitionDiff(current, definition); len(diff) > 0 {
+			changes = append(changes, iamChange{Action: "update", Kind: "policy", Name: definition.Name, Diff: diff, apply: func() error {
+				body, err := state.policyBody(definition)
+				if err != nil {
+					return err
+				}
+				return httpLib.Client.Put(fmt.Sprintf("/v2/iam/policy/%s", url.PathEscape(existing.ID)), body, nil)
+			}})
+		}
+	}
+	var policyDeletes []iamChange
+	for name, existing := range existingPolicies {
+		if slices.ContainsFunc(policies, func(d iamPolicyDefinition) bool { return d.Name == name }) {
+			continue
+		}
+		if !IAMPolicyCodeParams.Prune {
+			kept++
+			continue
+		}
+		policyDeletes = append(policyDeletes, iamChange{Action: "delete", Kind: "policy", Name: name, apply: func() error {
+			return httpLib.Client.Delete(fmt.Sprintf("/v2/iam/policy/%s", url.PathEscape(existing.ID)), nil)
+		}})
+	}
+
+	sortIAMChanges(policyDeletes)
+
+	// Policies are deleted before the groups they may reference
+	deletes = append(policyDeletes, deletes...)
+
+	return append(changes, deletes...), kept, nil
+}
+
+func ImportIAMPolicies(_ *cobra.Command, _ []string) {
+	if info, err := os.Stat(IAMPolicyCodeParams.Dir); err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to read directory: %s", err)
+		return
+	} else if !info.IsDir() {
+		display.OutputError(&flags.OutputFormatConfig, "%s is not a directory", IAMPolicyCodeParams.Dir)
+		return
+	}
+
+	policies, err := readIAMDefinitions(filepath.Join(IAMPolicyCodeParams.Dir, iamPoliciesDir),
+		func(d iamPolicyDefinition) string { return d.Name })
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to read policies: %s", err)
+		return
+	}
+	permissionsGroups, err := readIAMDefinitions(filepath.Join(IAMPolicyCodeParams.Dir, iamPermissionsGroupsDir),
+		func(d iamPermissionsGroupDefinition) string { return d.Name })
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to read permissions groups: %s", err)
+		return
+	}
+	resourceGroups, err := readIAMDefinitions(filepath.Join(IAMPolicyCodeParams.Dir, iamResourceGroupsDir),
+		func(d iamResourceGroupDefinition) string { return d.Name })
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to read resource groups: %s", err)
+		return
+	}
+
+	if IAMPolicyCodeParams.Prune && len(policies)+len(permissionsGroups)+len(resourceGroups) == 0 {
+		display.OutputError(&flags.OutputFormatConfig, "no definition found in %s, refusing to delete all the policies and groups of the account", IAMPolicyCodeParams.Dir)
+		return
+	}
+
+	state, err := fetchIAMCodeState(false)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	changes, kept, err := planIAMImport(state, policies, permissionsGroups, resourceGroups)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	// Render the changes as a readable diff
+	var (
+		output strings.Builder
+		counts = map[string]int{}
+	)
+	for _, change := range changes {
+		counts[change.Action]++
+		switch change.Action {
+		case "create":
+			fmt.Fprintf(&output, "+ %s %s\n", change.Kind, change.Name)
+		case "update":
+			fmt.Fprintf(&output, "~ %s %s\n", change.Kind, change.Name)
+			for _, line := range change.Diff {
+				fmt.Fprintf(&output, "    %s\n", line)
+			}
+		case "delete":
+			fmt.Fprintf(&output, "- %s %s\n", change.Kind, change.Name)
+		}
+	}
+	if kept > 0 {
+		fmt.Fprintf(&output, "%d object(s) not defined in %s are kept, use --prune to delete them\n", kept, IAMPolicyCodeParams.Dir)
+	}
+
+	details := map[string]any{
+		"changes": changes,
+		"dryRun":  IAMPolicyCodeParams.DryRun,
+	}
+
+	switch {
+	case len(changes) == 0:
+		display.OutputInfo(&flags.OutputFormatConfig, details, "%s✅ IAM configuration is already up-to-date", output.String())
+		return
+	case IAMPolicyCodeParams.DryRun:
+		display.OutputInfo(&flags.OutputFormatConfig, details, "%sDry run: %d object(s) would be created, %d updated and %d deleted",
+			output.String(), counts["create"], counts["update"], counts["delete"])
+		return
+	}
+
+	for _, change := range changes {
+		if err := change.apply(); err != nil {
+			display.OutputError(&flags.OutputFormatConfig, "failed to %s %s %s: %s", change.Action, change.Kind, change.Name, err)
+			return
+		}
+	}
+
+	display.OutputInfo(&flags.OutputFormatConfig, details, "%s✅ IAM configuration imported: %d object(s) created, %d updated and %d deleted",
+		output.String(), counts["create"], counts["update"], counts["delete"])
+}
@@ -0,0 +1,231 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package iam
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/ovh/ovhcloud-cli/internal/display"
+	filtersLib "github.com/ovh/ovhcloud-cli/internal/filters"
+	"github.com/ovh/ovhcloud-cli/internal/flags"
+	httpLib "github.com/ovh/ovhcloud-cli/internal/http"
+	"github.com/ovh/ovhcloud-cli/internal/services/common"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// IAMResourceTagFilters holds the tags that listed IAM resources must have.
+	IAMResourceTagFilters map[string]string
+)
+
+// iamResourceTags returns the tags of the given IAM resource
+func iamResourceTags(resource map[string]any) map[string]any {
+	tags, _ := resource["tags"].(map[string]any)
+	return tags
+}
+
+// matchIAMResourceTags returns true if the given resource has all the given tags
+func matchIAMResourceTags(resource map[string]any, tags map[string]string) bool {
+	resourceTags := iamResourceTags(resource)
+	for key, value := range tags {
+		if current, ok := resourceTags[key]; !ok || fmt.Sprint(current) != value {
+			return false
+		}
+	}
+
+	return true
+}
+
+// fetchIAMResources returns all the IAM resources of the account
+func fetchIAMResources() ([]map[string]any, error) {
+	body, err := httpLib.FetchArray("/v2/iam/resource", "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch IAM resources: %w", err)
+	}
+
+	resources := make([]map[string]any, 0, len(body))
+	for _, resource := range body {
+		resources = append(resources, resource.(map[string]any))
+	}
+
+	return resources, nil
+}
+
+func ListIAMResources(_ *cobra.Command, _ []string) {
+	resources, err := fetchIAMResources()
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	resources = slices.DeleteFunc(resources, func(resource map[string]any) bool {
+		return !matchIAMResourceTags(resource, IAMResourceTagFilters)
+	})
+
+	columnsToDisplay := iamResourceColumnsToDisplay
+	if flags.ShowIAMTags {
+		for _, resource := range resources {
+			resource[common.IAMTagsField] = common.FormatIAMTags(iamResourceTags(resource))
+		}
+		columnsToDisplay = append(slices.Clone(columnsToDisplay), common.IAMTagsField+" tags")
+	}
+
+	resources, err = filtersLib.FilterLines(resources, flags.GenericFilters)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to filter results: %s", err)
+		return
+	}
+
+	display.RenderTable(resources, columnsToDisplay, &flags.OutputFormatConfig)
+}
+
+// parseIAMTagArgs parses the given key=value arguments. When allowKeyOnly is true,
+// arguments without value are accepted and mapped to a nil value.
+func parseIAMTagArgs(args []string, allowKeyOnly bool) (map[string]*string, error) {
+	tags := make(map[string]*string, len(args))
+	for _, arg := range args {
+		key, value, ok := strings.Cut(arg, "=")
+		switch {
+		case key == "":
+			return nil, fmt.Errorf("invalid tag %q, key is empty", arg)
+		case !ok && !allowKeyOnly:
+			return nil, fmt.Errorf("invalid tag %q, expected format is key=value", arg)
+		case ok:
+			tags[key] = &value
+		default:
+			tags[key] = nil
+		}
+	}
+
+	return tags, nil
+}
+
+// iamTagTargets returns the resources targeted by a tag command, along with the
+// remaining tag arguments. Resources are selected by the generic filters when
+// given, otherwise the first argument is the URN of the resource.
+func iamTagTargets(args []string) ([]map[string]any, []string, error) {
+	if len(flags.GenericFilters) > 0 {
+		resources, err := fetchIAMResources()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		resources, err = filtersLib.FilterLines(resources, flags.GenericFilters)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to filter results: %w", err)
+		}
+
+		return resources, args, nil
+	}
+
+	if len(args) < 2 {
+		return nil, nil, errors.New("a resource URN and at least one tag must be given, or use --filter to select resources")
+	}
+
+	var resource map[string]any
+	if err := httpLib.Client.Get(fmt.Sprintf("/v2/iam/resource/%s", url.PathEscape(args[0])), &resource); err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch resource %s: %w", args[0], err)
+	}
+
+	return []map[string]any{resource}, args[1:], nil
+}
+
+// outputIAMTagChanges displays the result of a tag command
+func outputIAMTagChanges(action string, changes map[string][]string, total int) {
+	if total == 0 {
+		display.OutputInfo(&flags.OutputFormatConfig, changes, "No resource matches the given filters")
+		return
+	}
+
+	display.OutputInfo(&flags.OutputFormatConfig, changes, "✅ Tags %s %d resource(s), %d already up-to-date", action, len(changes), total-len(changes))
+}
+
+func AddIAMResourceTags(_ *cobra.Command, args []string) {
+	resources, args, err := iamTagTargets(args)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	tags, err := parseIAMTagArgs(args, false)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+	if len(tags) == 0 {
+		display.OutputError(&flags.OutputFormatConfig, "at least one tag must be given")
+		return
+	}
+	keys := slices.Sorted(maps.Keys(tags))
+
+	changes := make(map[string][]string)
+	for _, resource := range resources {
+		urn, _ := resource["urn"].(string)
+		current := iamResourceTags(resource)
+
+		for _, key := range keys {
+			if value, ok := current[key]; ok && fmt.Sprint(value) == *tags[key] {
+				continue
+			}
+
+			if err := httpLib.Client.Post(fmt.Sprintf("/v2/iam/resource/%s/tag", url.PathEscape(urn)), map[string]string{
+				"key":   key,
+				"value": *tags[key],
+			}, nil); err != nil {
+				display.OutputError(&flags.OutputFormatConfig, "failed to add tag %s to resource %s: %s", key, urn, err)
+				return
+			}
+			changes[urn] = append(changes[urn], key+"="+*tags[key])
+		}
+	}
+
+	outputIAMTagChanges("added to", changes, len(resources))
+}
+
+func RemoveIAMResourceTags(_ *cobra.Command, args []string) {
+	resources, args, err := iamTagTargets(args)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	tags, err := parseIAMTagArgs(args, true)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+	if len(tags) == 0 {
+		display.OutputError(&flags.OutputFormatConfig, "at least one tag must be given")
+		return
+	}
+	keys := slices.Sorted(maps.Keys(tags))
+
+	changes := make(map[string][]string)
+	for _, resource := range resources {
+		urn, _ := resource["urn"].(string)
+		current := iamResourceTags(resource)
+
+		for _, key := range keys {
+			// When a value is given, the tag is only removed if it has this value
+			value, ok := current[key]
+			if !ok || (tags[key] != nil && fmt.Sprint(value) != *tags[key]) {
+				continue
+			}
+
+			if err := httpLib.Client.Delete(fmt.Sprintf("/v2/iam/resource/%s/tag/%s", url.PathEscape(urn), url.PathEscape(key)), nil); err != nil {
+				display.OutputError(&flags.OutputFormatConfig, "failed to remove tag %s from resource %s: %s", key, urn, err)
+				return
+			}
+			changes[urn] = append(changes[urn], key)
+		}
+	}
+
+	outputIAMTagChanges("removed from", changes, len(resources))
+}
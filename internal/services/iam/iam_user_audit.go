@@ -0,0 +1,234 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package iam
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/ovh/ovhcloud-cli/internal/display"
+	filtersLib "github.com/ovh/ovhcloud-cli/internal/filters"
+	"github.com/ovh/ovhcloud-cli/internal/flags"
+	httpLib "github.com/ovh/ovhcloud-cli/internal/http"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// IAMUserAuditStaleDays is the number of days without login after which a user is considered stale.
+	IAMUserAuditStaleDays int
+
+	// IAMTokenRotateName is the name of the token replacing the rotated one.
+	IAMTokenRotateName string
+
+	iamUserAuditColumnsToDisplay = []string{"login", "group", "status", "lastLogin", "mfa", "tokens", "warnings"}
+
+	// Suffix added to the names of rotated tokens
+	iamRotatedTokenSuffix = regexp.MustCompile(`-\d{14}$`)
+)
+
+type (
+	iamUser struct {
+		Login              string `json:"login"`
+		Group              string `json:"group"`
+		Status             string `json:"status"`
+		Type               string `json:"type"`
+		Creation           string `json:"creation"`
+		PasswordLastUpdate string `json:"passwordLastUpdate"`
+	}
+
+	iamUserToken struct {
+		Name        string `json:"name"`
+		Description string `json:"description"`
+		Creation    string `json:"creation,omitempty"`
+		ExpiresAt   string `json:"expiresAt,omitempty"`
+		LastUsed    string `json:"lastUsed,omitempty"`
+	}
+
+	iamAuditLog struct {
+		Type        string `json:"type"`
+		CreatedAt   string `json:"createdAt"`
+		AuthDetails struct {
+			UserDetails struct {
+				Type string `json:"type"`
+				User string `json:"user"`
+			} `json:"userDetails"`
+		} `json:"authDetails"`
+		LoginSuccessDetails struct {
+			MFAType string `json:"mfaType"`
+		} `json:"loginSuccessDetails"`
+	}
+)
+
+// iamUserLogins returns the latest successful login of each local user found in the audit logs
+func iamUserLogins() (map[string]iamAuditLog, error) {
+	logs, err := fetchIAMList[iamAuditLog]("/v1/me/logs/audit")
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch audit logs: %w", err)
+	}
+
+	logins := make(map[string]iamAuditLog)
+	for _, log := range logs {
+		if log.Type != "LOGIN_SUCCESS" || log.AuthDetails.UserDetails.Type != "USER" {
+			continue
+		}
+
+		user := log.AuthDetails.UserDetails.User
+		if previous, ok := logins[user]; !ok || previous.CreatedAt < log.CreatedAt {
+			logins[user] = log
+		}
+	}
+
+	return logins, nil
+}
+
+// parseIAMDate parses the given date, returning a zero time if it is not set
+func parseIAMDate(date string) time.Time {
+	parsed, err := time.Parse(time.RFC3339, date)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return parsed
+}
+
+func AuditUsers(_ *cobra.Command, _ []string) {
+	logins, err := httpLib.FetchArray("/v1/me/identity/user", "")
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to fetch users: %s", err)
+		return
+	}
+
+	users, err := httpLib.FetchObjectsParallel[iamUser]("/v1/me/identity/user/%s", logins, false)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to fetch users: %s", err)
+		return
+	}
+
+	lastLogins, err := iamUserLogins()
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	var (
+		now            = time.Now()
+		staleThreshold = now.AddDate(0, 0, -IAMUserAuditStaleDays)
+		report         []map[string]any
+	)
+	for _, user := range users {
+		tokensPath := fmt.Sprintf("/v1/me/identity/user/%s/token", url.PathEscape(user.Login))
+		tokenNames, err := httpLib.FetchArray(tokensPath, "")
+		if err != nil {
+			display.OutputError(&flags.OutputFormatConfig, "failed to fetch tokens of user %s: %s", user.Login, err)
+			return
+		}
+		tokens, err := httpLib.FetchObjectsParallel[iamUserToken](tokensPath+"/%s", tokenNames, false)
+		if err != nil {
+			display.OutputError(&flags.OutputFormatConfig, "failed to fetch tokens of user %s: %s", user.Login, err)
+			return
+		}
+
+		var warnings []string
+
+		// Users that never logged in are considered stale once they are older than the threshold
+		lastLogin, mfa := "never", "unknown"
+		lastActivity := parseIAMDate(user.Creation)
+		if login, ok := lastLogins[user.Login]; ok {
+			lastLogin = login.CreatedAt
+			lastActivity = parseIAMDate(login.CreatedAt)
+			switch login.LoginSuccessDetails.MFAType {
+			case "", "NONE":
+				mfa = "none"
+			default:
+				mfa = login.LoginSuccessDetails.MFAType
+			}
+		}
+		if lastActivity.Before(staleThreshold) {
+			warnings = append(warnings, fmt.Sprintf("no login for more than %d days", IAMUserAuditStaleDays))
+		}
+		if mfa == "none" {
+			warnings = append(warnings, "no MFA")
+		}
+
+		tokensSummary := make([]string, 0, len(tokens))
+		for _, token := range tokens {
+			switch expiresAt := parseIAMDate(token.ExpiresAt); {
+			case token.ExpiresAt == "":
+				tokensSummary = append(tokensSummary, token.Name+": never expires")
+				warnings = append(warnings, fmt.Sprintf("token %s never expires", token.Name))
+			case expiresAt.Before(now):
+				tokensSummary = append(tokensSummary, token.Name+": expired")
+			default:
+				tokensSummary = append(tokensSummary, fmt.Sprintf("%s: expires %s", token.Name, expiresAt.Format(time.DateOnly)))
+			}
+		}
+
+		report = append(report, map[string]any{
+			"login":              user.Login,
+			"group":              user.Group,
+			"status":             user.Status,
+			"type":               user.Type,
+			"creation":           user.Creation,
+			"passwordLastUpdate": user.PasswordLastUpdate,
+			"lastLogin":          lastLogin,
+			"mfa":                mfa,
+			"tokens":             strings.Join(tokensSummary, ", "),
+			"tokensCount":        len(tokens),
+			"tokensDetails":      tokens,
+			"warnings":           strings.Join(warnings, ", "),
+			"stale":              lastActivity.Before(staleThreshold),
+		})
+	}
+
+	report, err = filtersLib.FilterLines(report, flags.GenericFilters)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to filter results: %s", err)
+		return
+	}
+
+	display.RenderTable(report, iamUserAuditColumnsToDisplay, &flags.OutputFormatConfig)
+}
+
+func RotateUserToken(_ *cobra.Command, args []string) {
+	tokensPath := fmt.Sprintf("/v1/me/identity/user/%s/token", url.PathEscape(args[0]))
+
+	var token iamUserToken
+	if err := httpLib.Client.Get(fmt.Sprintf("%s/%s", tokensPath, url.PathEscape(args[1])), &token); err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to fetch token %s of user %s: %s", args[1], args[0], err)
+		return
+	}
+
+	name := IAMTokenRotateName
+	if name == "" {
+		name = iamRotatedTokenSuffix.ReplaceAllString(token.Name, "") + "-" + time.Now().UTC().Format("20060102150405")
+	}
+
+	// The replacement token keeps the same validity duration
+	body := map[string]any{
+		"name":        name,
+		"description": token.Description,
+	}
+	if token.ExpiresAt != "" {
+		if validity := parseIAMDate(token.ExpiresAt).Sub(parseIAMDate(token.Creation)); validity > 0 {
+			body["expiresIn"] = int(validity.Seconds())
+		}
+	}
+
+	var newToken map[string]any
+	if err := httpLib.Client.Post(tokensPath, body, &newToken); err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to create replacement token: %s", err)
+		return
+	}
+
+	if err := httpLib.Client.Delete(fmt.Sprintf("%s/%s", tokensPath, url.PathEscape(token.Name)), nil); err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "replacement token %s created (value: %s), but failed to delete token %s: %s", name, newToken["token"], token.Name, err)
+		return
+	}
+
+	display.OutputInfo(&flags.OutputFormatConfig, newToken, "✅ Token %s of user %s replaced by token %s, value: %s", token.Name, args[0], name, newToken["token"])
+}
@@ -0,0 +1,254 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ssh
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"slices"
+	"strings"
+
+	"github.com/ovh/ovhcloud-cli/internal/display"
+	"github.com/ovh/ovhcloud-cli/internal/flags"
+	httpLib "github.com/ovh/ovhcloud-cli/internal/http"
+	"github.com/ovh/ovhcloud-cli/internal/services/baremetal"
+	"github.com/ovh/ovhcloud-cli/internal/services/cloud"
+	"github.com/ovh/ovhcloud-cli/internal/services/vps"
+	"github.com/spf13/cobra"
+)
+
+const (
+	TargetTypeInstance  = "instance"
+	TargetTypeVps       = "vps"
+	TargetTypeBaremetal = "baremetal"
+)
+
+var (
+	// SSHParams holds the options of the ssh command.
+	SSHParams struct {
+		TargetType   string
+		User         string
+		Private      bool
+		Jump         string
+		JumpPrivate  bool
+		IdentityFile string
+		Port         int
+		PrintOnly    bool
+	}
+
+	// Default users by OS family, matched against image or OS names
+	defaultUsersByOS = []struct {
+		prefix string
+		user   string
+	}{
+		{"ubuntu", "ubuntu"},
+		{"debian", "debian"},
+		{"centos", "centos"},
+		{"almalinux", "almalinux"},
+		{"alma", "almalinux"},
+		{"rocky", "rocky"},
+		{"fedora", "fedora"},
+		{"archlinux", "arch"},
+		{"freebsd", "freebsd"},
+	}
+)
+
+// target is a resolved SSH destination
+type target struct {
+	kind string
+	ip   string
+	user string
+}
+
+func (t target) String() string {
+	if t.user == "" {
+		return t.ip
+	}
+	return t.user + "@" + t.ip
+}
+
+// defaultUserForOS returns the default user of the given image or OS name
+func defaultUserForOS(osName string) string {
+	normalized := strings.ToLower(strings.ReplaceAll(osName, " ", ""))
+	for _, candidate := range defaultUsersByOS {
+		if strings.HasPrefix(normalized, candidate.prefix) {
+			return candidate.user
+		}
+	}
+
+	return "root"
+}
+
+// detectTargetType finds whether the given name is a VPS, a baremetal server
+// or a cloud instance. A product that cannot be listed, e.g. because the
+// credentials are not allowed to, is skipped.
+func detectTargetType(name string) string {
+	for _, candidate := range []struct {
+		kind, endpoint string
+	}{
+		{TargetTypeVps, "/v1/vps"},
+		{TargetTypeBaremetal, "/v1/dedicated/server"},
+	} {
+		var services []string
+		if err := httpLib.Client.Get(candidate.endpoint, &services); err != nil {
+			log.Printf("Failed to list %s services, skipping them: %s", candidate.kind, err)
+			continue
+		}
+		if slices.Contains(services, name) {
+			return candidate.kind
+		}
+	}
+
+	return TargetTypeInstance
+}
+
+// resolveTarget resolves a target formatted as [user@][type/]name
+func resolveTarget(rawTarget, targetType string, private bool) (target, error) {
+	var resolved target
+
+	name := rawTarget
+	if user, rest, ok := strings.Cut(rawTarget, "@"); ok {
+		resolved.user, name = user, rest
+	}
+	if kind, rest, ok := strings.Cut(name, "/"); ok && slices.Contains([]string{TargetTypeInstance, TargetTypeVps, TargetTypeBaremetal}, kind) {
+		targetType, name = kind, rest
+	}
+
+	if targetType == "" {
+		targetType = detectTargetType(name)
+	}
+	resolved.kind = targetType
+
+	if private && targetType != TargetTypeInstance {
+		return resolved, errors.New("private addresses can only be used with cloud instances")
+	}
+
+	var (
+		ip, osName, user string
+		err              error
+	)
+	switch targetType {
+	case TargetTypeInstance:
+		ip, user, osName, err = cloud.GetInstanceSSHTarget(name, private)
+	case TargetTypeVps:
+		ip, osName, err = vps.GetVpsSSHTarget(name)
+	case TargetTypeBaremetal:
+		ip, osName, err = baremetal.GetBaremetalSSHTarget(name)
+	default:
+		return resolved, fmt.Errorf("invalid target type %q, expected one of instance, vps, baremetal", targetType)
+	}
+	if err != nil {
+		return resolved, err
+	}
+
+	resolved.ip = ip
+	if resolved.user == "" {
+		if user == "" {
+			user = defaultUserForOS(osName)
+		}
+		resolved.user = user
+	}
+
+	return resolved, nil
+}
+
+// buildSSHArgs builds the arguments of the ssh client
+func buildSSHArgs(destination target, jump *target, extraArgs []string) []string {
+	var args []string
+
+	if jump != nil {
+		args = append(args, "-J", jump.String())
+	}
+	if SSHParams.IdentityFile != "" {
+		args = append(args, "-i", SSHParams.IdentityFile)
+	}
+	if SSHParams.Port != 0 {
+		args = append(args, "-p", fmt.Sprint(SSHParams.Port))
+	}
+
+	args = append(args, destination.String())
+
+	return append(args, extraArgs...)
+}
+
+func shellQuote(arg string) string {
+	if arg != "" && !strings.ContainsAny(arg, " \t\n'\"\\$`&|;<>()*?[]{}!#~") {
+		return arg
+	}
+	return "'" + strings.ReplaceAll(arg, "'", `'\''`) + "'"
+}
+
+func SSH(cmd *cobra.Command, args []string) {
+	// Arguments after "--" are given to the ssh client
+	extraArgs := []string{}
+	if dash := cmd.ArgsLenAtDash(); dash >= 0 {
+		extraArgs = args[dash:]
+		args = args[:dash]
+	}
+	if len(args) != 1 {
+		display.OutputError(&flags.OutputFormatConfig, "a single target must be given\n\n%s", cmd.UsageString())
+		return
+	}
+
+	destination, err := resolveTarget(args[0], SSHParams.TargetType, SSHParams.Private)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to resolve target: %s", err)
+		return
+	}
+	if SSHParams.User != "" {
+		destination.user = SSHParams.User
+	}
+
+	var jump *target
+	if SSHParams.Jump != "" {
+		resolvedJump, err := resolveTarget(SSHParams.Jump, "", SSHParams.JumpPrivate)
+		if err != nil {
+			display.OutputError(&flags.OutputFormatConfig, "failed to resolve jump host: %s", err)
+			return
+		}
+		jump = &resolvedJump
+	}
+
+	sshArgs := buildSSHArgs(destination, jump, extraArgs)
+
+	if SSHParams.PrintOnly {
+		quoted := make([]string, 0, len(sshArgs)+1)
+		quoted = append(quoted, "ssh")
+		for _, arg := range sshArgs {
+			quoted = append(quoted, shellQuote(arg))
+		}
+
+		display.OutputInfo(&flags.OutputFormatConfig, map[string]any{
+			"type": destination.kind,
+			"ip":   destination.ip,
+			"user": destination.user,
+			"args": sshArgs,
+		}, "%s", strings.Join(quoted, " "))
+		return
+	}
+
+	sshPath, err := exec.LookPath("ssh")
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "ssh client not found in PATH: %s", err)
+		return
+	}
+
+	log.Printf("Connecting to %s %s (%s)", destination.kind, args[0], destination)
+
+	sshCmd := exec.Command(sshPath, sshArgs...)
+	sshCmd.Stdin = os.Stdin
+	sshCmd.Stdout = os.Stdout
+	sshCmd.Stderr = os.Stderr
+	if err := sshCmd.Run(); err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			os.Exit(exitErr.ExitCode())
+		}
+		display.OutputError(&flags.OutputFormatConfig, "failed to run ssh: %s", err)
+	}
+}
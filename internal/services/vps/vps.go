@@ -643,3 +643,36 @@ func ListVpsTasks(_ *cobra.Command, args []string) {
 	endpoint := fmt.Sprintf("/v1/vps/%s/tasks", url.PathEscape(args[0]))
 	common.ManageListRequest(endpoint, "", []string{"id", "type", "state", "date", "progress"}, flags.GenericFilters)
 }
+
+// GetVpsSSHTarget returns the primary IPv4 address of the given VPS and
+// the name of its current image
+func GetVpsSSHTarget(serviceName string) (string, string, error) {
+	endpoint := fmt.Sprintf("/v1/vps/%s/ips", url.PathEscape(serviceName))
+
+	ips, err := httpLib.FetchExpandedArray(endpoint, "")
+	if err != nil {
+		return "", "", fmt.Errorf("failed to fetch IPs of VPS %s: %w", serviceName, err)
+	}
+
+	var address string
+	for _, ip := range ips {
+		if ip["version"] == "v4" {
+			address, _ = ip["ipAddress"].(string)
+			if ip["type"] == "primary" {
+				break
+			}
+		}
+	}
+	if address == "" {
+		return "", "", fmt.Errorf("VPS %s has no IPv4 address", serviceName)
+	}
+
+	var image struct {
+		Name string `json:"name"`
+	}
+	if err := httpLib.Client.Get(fmt.Sprintf("/v1/vps/%s/images/current", url.PathEscape(serviceName)), &image); err != nil {
+		return "", "", fmt.Errorf("failed to fetch current image of VPS %s: %w", serviceName, err)
+	}
+
+	return address, image.Name, nil
+}
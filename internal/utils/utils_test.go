@@ -6,6 +6,7 @@ package utils
 
 import (
 	"testing"
+	"time"
 
 	"github.com/maxatome/go-testdeep/td"
 )
@@ -70,3 +71,21 @@ func TestMergeMaps_WrongType(t *testing.T) {
 	td.CmpNoError(t, err)
 	td.Cmp(t, left, expected)
 }
+
+func TestParseDuration(t *testing.T) {
+	for value, expected := range map[string]time.Duration{
+		"30d": 30 * 24 * time.Hour,
+		"2w":  14 * 24 * time.Hour,
+		"12h": 12 * time.Hour,
+		"90m": 90 * time.Minute,
+	} {
+		duration, err := ParseDuration(value)
+		td.CmpNoError(t, err, value)
+		td.Cmp(t, duration, expected, value)
+	}
+
+	for _, value := range []string{"", "d", "-3d", "1.5w", "tomorrow"} {
+		_, err := ParseDuration(value)
+		td.CmpError(t, err, value)
+	}
+}